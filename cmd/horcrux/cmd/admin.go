@@ -0,0 +1,305 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"reflect"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/horcrux/signer"
+	"github.com/strangelove-ventures/horcrux/signer/proto"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"gopkg.in/yaml.v2"
+)
+
+func init() {
+	adminCmd.AddCommand(adminPauseCmd())
+	adminCmd.AddCommand(adminResumeCmd())
+	adminCmd.AddCommand(adminReloadCmd())
+	adminCmd.AddCommand(adminPeersCmd())
+
+	rootCmd.AddCommand(adminCmd)
+}
+
+var adminCmd = &cobra.Command{
+	Use:   "admin",
+	Short: "Commands to operate the running cosigner of this node through its admin API",
+}
+
+// adminGRPCClient dials the admin API of the cosigner running on this node
+func adminGRPCClient() (proto.AdminGRPCClient, *grpc.ClientConn, error) {
+	token, err := signer.LoadAdminToken(config.adminTokenFile())
+	if err != nil {
+		return nil, nil, err
+	}
+	target, err := signer.AdminDialTarget(config.adminListenAddress())
+	if err != nil {
+		return nil, nil, err
+	}
+	conn, err := grpc.Dial(target,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithPerRPCCredentials(signer.AdminTokenCredentials(token)))
+	if err != nil {
+		return nil, nil, err
+	}
+	return proto.NewAdminGRPCClient(conn), conn, nil
+}
+
+// runningAdminGRPCClient dials the admin API if horcrux is running on this node, returns nil if it is not.
+// notRunningErr is the reason horcrux is considered running.
+func runningAdminGRPCClient() (grpcClient proto.AdminGRPCClient, conn *grpc.ClientConn, err error) {
+	notRunningErr := signer.RequireNotRunning(config.PidFile)
+	if notRunningErr == nil {
+		return nil, nil, nil
+	}
+	grpcClient, conn, err = adminGRPCClient()
+	if err != nil {
+		return nil, nil, fmt.Errorf("%v, and its admin API is not available: %w", notRunningErr, err)
+	}
+	return grpcClient, conn, nil
+}
+
+func adminContext() (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.Background(), 30*time.Second)
+}
+
+func adminPauseCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause",
+		Short: "Stop signing without stopping the cosigner",
+		Long: "Stop signing votes and proposals, the sentries get an error for every sign request.\n" +
+			"The cosigner keeps its raft membership, and the sign state can be set with horcrux state set.\n" +
+			"With --cluster, signing stops on all cosigners and stays paused across restarts until\n" +
+			"horcrux admin resume --cluster, or until the resume height has been signed.",
+		Example: `horcrux admin pause --reason "state migration"
+horcrux admin pause --cluster --reason "chain upgrade" --resume-height 1500001`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cluster, _ := cmd.Flags().GetBool("cluster")
+			resumeHeight, _ := cmd.Flags().GetInt64("resume-height")
+			if resumeHeight != 0 && !cluster {
+				return fmt.Errorf("--resume-height requires --cluster")
+			}
+
+			grpcClient, conn, err := adminGRPCClient()
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			ctx, cancelFunc := adminContext()
+			defer cancelFunc()
+
+			reason, _ := cmd.Flags().GetString("reason")
+			if _, err := grpcClient.Pause(ctx, &proto.AdminGRPCPauseRequest{
+				Reason:       reason,
+				Cluster:      cluster,
+				ResumeHeight: resumeHeight,
+			}); err != nil {
+				return err
+			}
+			if !cluster {
+				fmt.Fprintln(cmd.OutOrStdout(), "Signing paused")
+				recordAdminAction(cmd, newAdminAction(cmd, args))
+				return nil
+			}
+			if resumeHeight != 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "Signing paused on all cosigners, resuming at height %d\n", resumeHeight)
+			} else {
+				fmt.Fprintln(cmd.OutOrStdout(), "Signing paused on all cosigners")
+			}
+			recordClusterAdminAction(cmd, replicateWithAdmin(grpcClient), newAdminAction(cmd, args))
+			return nil
+		},
+	}
+	cmd.Flags().String("reason", "", "reason for the pause, shown in the state and the sign errors")
+	cmd.Flags().Bool("cluster", false, "pause signing on all cosigners through raft")
+	cmd.Flags().Int64("resume-height", 0, "resume signing of the cluster automatically at this block height")
+	return cmd
+}
+
+func adminResumeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "resume",
+		Short:        "Resume signing after a pause",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cluster, _ := cmd.Flags().GetBool("cluster")
+
+			grpcClient, conn, err := adminGRPCClient()
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			ctx, cancelFunc := adminContext()
+			defer cancelFunc()
+
+			res, err := grpcClient.Resume(ctx, &proto.AdminGRPCResumeRequest{Cluster: cluster})
+			if err != nil {
+				return err
+			}
+			switch {
+			case !res.WasPaused && cluster:
+				fmt.Fprintln(cmd.OutOrStdout(), "Signing was not paused on all cosigners")
+				return nil
+			case !res.WasPaused:
+				fmt.Fprintln(cmd.OutOrStdout(), "Signing was not paused")
+				return nil
+			case cluster:
+				fmt.Fprintln(cmd.OutOrStdout(), "Signing resumed on all cosigners")
+				recordClusterAdminAction(cmd, replicateWithAdmin(grpcClient), newAdminAction(cmd, args))
+			default:
+				fmt.Fprintln(cmd.OutOrStdout(), "Signing resumed")
+				recordAdminAction(cmd, newAdminAction(cmd, args))
+			}
+			return nil
+		},
+	}
+	cmd.Flags().Bool("cluster", false, "resume signing on all cosigners through raft")
+	return cmd
+}
+
+func adminReloadCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "reload",
+		Short: "Apply the changes of the config file to the running cosigner",
+		Long: "Re-read the config file and apply the changes to the chain nodes, alerting and upgrade plan.\n" +
+			"Changes to other settings require a restart.",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			grpcClient, conn, err := adminGRPCClient()
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			ctx, cancelFunc := adminContext()
+			defer cancelFunc()
+
+			res, err := grpcClient.ReloadConfig(ctx, &proto.AdminGRPCReloadConfigRequest{})
+			if err != nil {
+				return err
+			}
+			if len(res.Changes) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "No changes")
+				return nil
+			}
+			for _, change := range res.Changes {
+				fmt.Fprintln(cmd.OutOrStdout(), change)
+			}
+			action := newAdminAction(cmd, args)
+			_ = action.SetAfter(res.Changes)
+			recordAdminAction(cmd, action)
+			return nil
+		},
+	}
+}
+
+func adminPeersCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:          "peers",
+		Short:        "Show the cosigner peers, the raft leader and the sentries of the running cosigner",
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			grpcClient, conn, err := adminGRPCClient()
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+			ctx, cancelFunc := adminContext()
+			defer cancelFunc()
+
+			res, err := grpcClient.ListPeers(ctx, &proto.AdminGRPCListPeersRequest{})
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Leader: %s\n\n", orDefault(res.Leader, "-"))
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "PEER\tADDRESS")
+			for _, peer := range res.Peers {
+				fmt.Fprintf(w, "%d\t%s\n", peer.ShareID, peer.Address)
+			}
+			fmt.Fprintln(w, "\nSENTRY\tCONNECTED")
+			for _, sentry := range res.Sentries {
+				fmt.Fprintf(w, "%s\t%t\n", sentry.Address, sentry.Connected)
+			}
+			return w.Flush()
+		},
+	}
+}
+
+// reloadCosignerConfig re-reads the config file and applies the changes to the chain nodes, alerting
+// and upgrade plan of the running cosigner. Returns a description of each change.
+func reloadCosignerConfig(logger tmlog.Logger, remoteSigners *signer.RemoteSigners,
+	upgrade *signer.ChainUpgrade) ([]string, error) {
+	bz, err := os.ReadFile(config.ConfigFile)
+	if err != nil {
+		return nil, err
+	}
+	var cfg DiskConfig
+	if err := yaml.Unmarshal(bz, &cfg); err != nil {
+		return nil, fmt.Errorf("error parsing config file: %w", err)
+	}
+	if err := validateCosignerConfig(cfg); err != nil {
+		return nil, err
+	}
+
+	var changes []string
+	if !reflect.DeepEqual(cfg.Nodes(), config.Config.Nodes()) {
+		added, removed, err := remoteSigners.SetNodes(cfg.Nodes())
+		if err != nil {
+			return nil, err
+		}
+		for _, address := range added {
+			changes = append(changes, "Added chain node "+address)
+		}
+		for _, address := range removed {
+			changes = append(changes, "Removed chain node "+address)
+		}
+		if len(added) == 0 && len(removed) == 0 {
+			changes = append(changes, "Changed chain nodes, connecting once the cosigner is ready")
+		}
+		config.Config.ChainNodes = cfg.ChainNodes
+	}
+	if !yamlEqual(cfg.Alerting, config.Config.Alerting) {
+		config.Config.Alerting = cfg.Alerting
+		signer.SetAlerter(nil)
+		startAlerting(logger)
+		changes = append(changes, "Reloaded alerting")
+	}
+	if !yamlEqual(cfg.Upgrade, config.Config.Upgrade) {
+		if err := upgrade.SetPlan(cfg.UpgradePlan()); err != nil {
+			return nil, err
+		}
+		config.Config.Upgrade = cfg.Upgrade
+		changes = append(changes, "Reloaded upgrade plan")
+	}
+
+	// peers change at runtime through raft, not through the config file
+	cfg.CosignerConfig.Peers = config.Config.CosignerConfig.Peers
+	if !yamlEqual(cfg, config.Config) {
+		changes = append(changes, "Other changes of the config file require a restart")
+	}
+	return changes, nil
+}
+
+// yamlEqual returns true if both have the same config file representation
+func yamlEqual(a, b interface{}) bool {
+	aYaml, err := yaml.Marshal(a)
+	if err != nil {
+		return false
+	}
+	bYaml, err := yaml.Marshal(b)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(aYaml, bYaml)
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"io"
+	"testing"
+
+	"github.com/strangelove-ventures/horcrux/signer"
+	"github.com/stretchr/testify/require"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+)
+
+func TestReloadCosignerConfig(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	cmd := initCmd()
+	cmd.SetOutput(io.Discard)
+	cmd.SetArgs([]string{
+		"horcrux-1",
+		"tcp://10.168.0.1:1234",
+		"-c",
+		"-t", "2",
+		"-p", "tcp://10.168.1.2:2222|2,tcp://10.168.1.3:2222|3",
+		"-l", "tcp://10.168.1.1:2222",
+	})
+	require.NoError(t, cmd.Execute())
+
+	logger := tmlog.NewNopLogger()
+	remoteSigners := signer.NewRemoteSigners(logger, "horcrux-1", nil, config.Config.Nodes(), nil)
+	upgrade := signer.NewChainUpgrade("horcrux-1", nil)
+
+	changes, err := reloadCosignerConfig(logger, remoteSigners, upgrade)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+
+	// edit the config file without changing the running config
+	running := config.Config
+	edited := running
+	edited.ChainNodes = append([]ChainNode{}, running.ChainNodes...)
+	edited.ChainNodes = append(edited.ChainNodes, ChainNode{PrivValAddr: "tcp://10.168.0.2:1234"})
+	edited.Alerting = &AlertingConfig{Notifiers: []AlertNotifierConfig{{Type: "webhook", URL: "http://localhost:9000"}}}
+	edited.Upgrade = &UpgradeConfig{HaltHeight: 1000, ChainID: "horcrux-2"}
+	cosignerConfig := *running.CosignerConfig
+	cosignerConfig.Timeout = "3s"
+	edited.CosignerConfig = &cosignerConfig
+	config.Config = edited
+	require.NoError(t, config.writeConfigFile())
+	config.Config = running
+
+	changes, err = reloadCosignerConfig(logger, remoteSigners, upgrade)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"Changed chain nodes, connecting once the cosigner is ready",
+		"Reloaded alerting",
+		"Reloaded upgrade plan",
+		"Other changes of the config file require a restart",
+	}, changes)
+	require.Len(t, config.Config.ChainNodes, 2)
+	require.NotNil(t, config.Config.Alerting)
+	require.Equal(t, "1500ms", config.Config.CosignerConfig.Timeout)
+	require.Len(t, remoteSigners.Status(), 2)
+	require.Equal(t, &signer.UpgradePlan{
+		ChainID:     "horcrux-1",
+		HaltHeight:  1000,
+		NewChainID:  "horcrux-2",
+		StartHeight: 1001,
+	}, upgrade.Plan())
+
+	signer.SetAlerter(nil)
+}
@@ -0,0 +1,289 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/strangelove-ventures/horcrux/signer"
+	"github.com/strangelove-ventures/horcrux/signer/proto"
+)
+
+func init() {
+	auditCmd.AddCommand(verifyAuditCmd())
+	auditCmd.AddCommand(queryAuditCmd())
+	auditCmd.AddCommand(adminAuditCmd())
+
+	rootCmd.AddCommand(auditCmd)
+}
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Commands to inspect the audit logs of the signatures and admin actions of this signer node",
+}
+
+// auditLogFileFlag returns the audit log file of the --file flag, or the one of the configured chain
+func auditLogFileFlag(cmd *cobra.Command) (string, error) {
+	filePath, _ := cmd.Flags().GetString("file")
+	if filePath == "" {
+		filePath = config.auditLogFile(config.Config.ChainID)
+	}
+	if _, err := os.Stat(filePath); err != nil {
+		return "", fmt.Errorf("audit log %s: %w", filePath, err)
+	}
+	return filePath, nil
+}
+
+func verifyAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify that the records of the audit log are intact and chained",
+		Long: "Verify that no record of the audit log has been changed, removed or reordered.\n" +
+			"The hashes are keyed with the audit key of the signer node that wrote the log.\n" +
+			"Note the hash of the last record to detect later truncation of the log.",
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filePath, err := auditLogFileFlag(cmd)
+			if err != nil {
+				return err
+			}
+			keyFile, _ := cmd.Flags().GetString("key-file")
+			if keyFile == "" {
+				keyFile = config.auditKeyFile()
+			}
+			key, err := signer.LoadAuditKey(keyFile)
+			if err != nil {
+				return err
+			}
+			last, count, err := signer.VerifyAuditLog(filePath, key)
+			if err != nil {
+				return fmt.Errorf("audit log %s is not intact after %d records: %w", filePath, count, err)
+			}
+			if count == 0 {
+				fmt.Fprintf(cmd.OutOrStdout(), "Audit log %s is empty\n", filePath)
+				return nil
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Audit log %s is intact\n", filePath)
+			fmt.Fprintf(cmd.OutOrStdout(), "  Records:     %d\n", count)
+			fmt.Fprintf(cmd.OutOrStdout(), "  Last record: %d at %d/%d/%d, %s\n",
+				last.Seq, last.Height, last.Round, last.Step, last.Time.Format(time.RFC3339))
+			fmt.Fprintf(cmd.OutOrStdout(), "  Last hash:   %s\n", last.Hash)
+			return nil
+		},
+	}
+	cmd.Flags().String("file", "", "audit log file (default is the audit log of the configured chain ID)")
+	cmd.Flags().String("key-file", "", "key of the audit log hashes (default is audit_key in the home directory)")
+	return cmd
+}
+
+func queryAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "query",
+		Short:        "Show the signatures in the audit log within a height range",
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filePath, err := auditLogFileFlag(cmd)
+			if err != nil {
+				return err
+			}
+			minHeight, _ := cmd.Flags().GetInt64("min-height")
+			maxHeight, _ := cmd.Flags().GetInt64("max-height")
+			if maxHeight != 0 && maxHeight < minHeight {
+				cmd.SilenceUsage = false
+				return fmt.Errorf("max-height %d is below min-height %d", maxHeight, minHeight)
+			}
+			role, _ := cmd.Flags().GetString("role")
+			if role != "" && role != signer.AuditRoleValidator && role != signer.AuditRoleCosigner {
+				cmd.SilenceUsage = false
+				return fmt.Errorf("role must be %s or %s", signer.AuditRoleValidator, signer.AuditRoleCosigner)
+			}
+
+			records, err := signer.ReadAuditLog(filePath, minHeight, maxHeight)
+			if err != nil {
+				return err
+			}
+			filtered := make([]signer.AuditRecord, 0, len(records))
+			for _, record := range records {
+				if role == "" || record.Role == role {
+					filtered = append(filtered, record)
+				}
+			}
+
+			if asJSON, _ := cmd.Flags().GetBool("json"); asJSON {
+				out, err := json.MarshalIndent(filtered, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(out))
+				return nil
+			}
+
+			if len(filtered) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "No signatures in the audit log for these heights")
+				return nil
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "SEQ\tTIME\tROLE\tHRS\tTYPE\tBLOCK ID\tCOSIGNERS\tSOURCE\tLATENCY")
+			for _, r := range filtered {
+				cosigners := make([]string, len(r.Cosigners))
+				for i, id := range r.Cosigners {
+					cosigners[i] = fmt.Sprint(id)
+				}
+				latency := time.Duration(r.LatencySeconds * float64(time.Second)).Round(time.Microsecond)
+				fmt.Fprintf(w, "%d\t%s\t%s\t%d/%d/%d\t%s\t%s\t%s\t%s\t%s\n",
+					r.Seq, r.Time.Format(time.RFC3339), r.Role, r.Height, r.Round, r.Step,
+					orDefault(r.Type, "-"), orDefault(r.BlockID, "nil"), strings.Join(cosigners, ","),
+					orDefault(r.Source, "-"), latency)
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().String("file", "", "audit log file (default is the audit log of the configured chain ID)")
+	cmd.Flags().Int64("min-height", 0, "only show signatures at or above this height")
+	cmd.Flags().Int64("max-height", 0, "only show signatures at or below this height (default no limit)")
+	cmd.Flags().String("role", "", "only show validator (combined) or cosigner (key share) signatures")
+	cmd.Flags().Bool("json", false, "print the records as JSON, with their hashes")
+	return cmd
+}
+
+// newAdminAction returns the admin action for the command with its arguments and flags as parameters
+func newAdminAction(cmd *cobra.Command, args []string) signer.AdminAction {
+	params := make(map[string]string)
+	if len(args) > 0 {
+		params["args"] = strings.Join(args, " ")
+	}
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		params[flag.Name] = flag.Value.String()
+	})
+	command := strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+	return signer.NewAdminAction(command, params)
+}
+
+// recordAdminAction appends the action to the local admin audit file.
+// The action has already been run, so a failure to record it is only reported.
+func recordAdminAction(cmd *cobra.Command, action signer.AdminAction) {
+	err := os.MkdirAll(config.StateDir, 0700)
+	if err == nil {
+		err = signer.AppendAdminAction(config.adminAuditFile(), action)
+	}
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to record %s in the admin audit file: %v\n", action.Command, err)
+	}
+}
+
+// recordClusterAdminAction records the action locally and replicates it to every cosigner through the raft leader
+// with the replicate function of a cosigner or admin API client.
+func recordClusterAdminAction(cmd *cobra.Command, replicate func(ctx context.Context, action []byte) error,
+	action signer.AdminAction) {
+	action.Cluster = true
+	recordAdminAction(cmd, action)
+
+	bz, err := json.Marshal(action)
+	if err == nil {
+		ctx, cancelFunc := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancelFunc()
+		err = replicate(ctx, bz)
+	}
+	if err != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: failed to record %s in the admin audit file of the cluster: %v\n",
+			action.Command, err)
+	}
+}
+
+// replicateWithCosigner replicates admin actions through the gRPC API of a cosigner,
+// signed with the RSA key of the cosigner on this node
+func replicateWithCosigner(grpcClient proto.CosignerGRPCClient) func(ctx context.Context, action []byte) error {
+	return func(ctx context.Context, action []byte) error {
+		key, err := signer.LoadCosignerKey(config.keyFilePath(true))
+		if err != nil {
+			return fmt.Errorf("error reading cosigner key to sign the admin action: %w", err)
+		}
+		req := &proto.CosignerGRPCRecordAdminActionRequest{Action: action}
+		if req.Auth, err = signer.SignClusterRequest(key.ID, &key.RSAKey, req); err != nil {
+			return err
+		}
+		_, err = grpcClient.RecordAdminAction(ctx, req)
+		return err
+	}
+}
+
+// replicateWithAdmin replicates admin actions through the admin API of the cosigner on this node
+func replicateWithAdmin(grpcClient proto.AdminGRPCClient) func(ctx context.Context, action []byte) error {
+	return func(ctx context.Context, action []byte) error {
+		_, err := grpcClient.RecordAdminAction(ctx, &proto.AdminGRPCRecordAdminActionRequest{Action: action})
+		return err
+	}
+}
+
+func adminAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "admin",
+		Short: "Show the history of admin actions that changed the state, config or leader of this signer node",
+		Long: "Show the history of admin actions run on this signer node, and the cluster wide actions\n" +
+			"replicated from the other cosigners, with who ran them and the values before and after",
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			actions, err := signer.ReadAdminActions(config.adminAuditFile())
+			if err != nil {
+				return err
+			}
+
+			command, _ := cmd.Flags().GetString("command")
+			filtered := make([]signer.AdminAction, 0, len(actions))
+			for _, action := range actions {
+				if command == "" || action.Command == command {
+					filtered = append(filtered, action)
+				}
+			}
+
+			if asJSON, _ := cmd.Flags().GetBool("json"); asJSON {
+				out, err := json.MarshalIndent(filtered, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(out))
+				return nil
+			}
+
+			if len(filtered) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "No admin actions")
+				return nil
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "TIME\tUSER\tHOST\tCOMMAND\tPARAMS\tBEFORE\tAFTER\tCLUSTER")
+			for _, a := range filtered {
+				params := make([]string, 0, len(a.Params))
+				for _, key := range sortedParamKeys(a.Params) {
+					params = append(params, key+"="+a.Params[key])
+				}
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%t\n",
+					a.Time.Format(time.RFC3339), orDefault(a.User, "-"), orDefault(a.Host, "-"), a.Command,
+					orDefault(strings.Join(params, " "), "-"), orDefault(string(a.Before), "-"),
+					orDefault(string(a.After), "-"), a.Cluster)
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().String("command", "", "only show actions of this command, i.e. \"state set\"")
+	cmd.Flags().Bool("json", false, "print the actions as JSON")
+	return cmd
+}
+
+func sortedParamKeys(params map[string]string) []string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,356 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/horcrux/client"
+	"github.com/strangelove-ventures/horcrux/signer/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func init() {
+	clusterCmd.AddCommand(addPeerCmd())
+	clusterCmd.AddCommand(removePeerCmd())
+	clusterCmd.AddCommand(clusterStatusCmd())
+	rootCmd.AddCommand(clusterCmd)
+}
+
+var clusterCmd = &cobra.Command{
+	Use:   "cluster",
+	Short: "Commands to manage the running cosigner cluster",
+}
+
+func addPeerCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "add-peer [share-id] [p2p-addr]",
+		Short: "add a cosigner to the running cluster",
+		Long: "add a cosigner to the running cluster, or change the address of an existing cosigner.\n\n" +
+			"The cosigner is added as a raft voter, and the new peer list is pushed to every cosigner\n" +
+			"and written to their config.yaml.\n\n" +
+			"[share-id] is the ID of the cosigner's key share i.e. 3\n" +
+			"[p2p-addr] is the p2p address of the cosigner i.e. tcp://10.168.1.3:2222",
+		Example: `horcrux cluster add-peer 3 tcp://10.168.1.3:2222`,
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			shareID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid share ID %s: %w", args[0], err)
+			}
+			if _, err := url.Parse(args[1]); err != nil {
+				return fmt.Errorf("invalid p2p address %s: %w", args[1], err)
+			}
+			if err := requireRaftCosignerConfig(); err != nil {
+				return err
+			}
+
+			// silence usage after all input has been validated
+			cmd.SilenceUsage = true
+
+			grpcClient, conn, err := leaderGRPCClient()
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			ctx, cancelFunc := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancelFunc()
+
+			_, err = grpcClient.AddPeer(ctx, &proto.CosignerGRPCAddPeerRequest{
+				ShareID: int32(shareID),
+				P2PAddr: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Added cosigner %d at %s to the cluster\n", shareID, args[1])
+
+			recordClusterAdminAction(cmd, replicateWithCosigner(grpcClient), newAdminAction(cmd, args))
+			return nil
+		},
+	}
+}
+
+func removePeerCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "remove-peer [share-id]",
+		Short: "remove a cosigner from the running cluster",
+		Long: "remove a cosigner from the running cluster.\n\n" +
+			"The cosigner is removed from the raft voters, and the new peer list is pushed to every cosigner\n" +
+			"and written to their config.yaml. The raft leader can not be removed.\n\n" +
+			"[share-id] is the ID of the cosigner's key share i.e. 3",
+		Example: `horcrux cluster remove-peer 3`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			shareID, err := strconv.Atoi(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid share ID %s: %w", args[0], err)
+			}
+			if err := requireRaftCosignerConfig(); err != nil {
+				return err
+			}
+
+			// silence usage after all input has been validated
+			cmd.SilenceUsage = true
+
+			grpcClient, conn, err := leaderGRPCClient()
+			if err != nil {
+				return err
+			}
+			defer conn.Close()
+
+			ctx, cancelFunc := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancelFunc()
+
+			_, err = grpcClient.RemovePeer(ctx, &proto.CosignerGRPCRemovePeerRequest{
+				ShareID: int32(shareID),
+			})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Removed cosigner %d from the cluster\n", shareID)
+
+			recordClusterAdminAction(cmd, replicateWithCosigner(grpcClient), newAdminAction(cmd, args))
+			return nil
+		},
+	}
+}
+
+// ClusterStatusHRS is the height, round and step of a sign state
+type ClusterStatusHRS struct {
+	Height int64 `json:"height"`
+	Round  int64 `json:"round"`
+	Step   int32 `json:"step"`
+}
+
+func (hrs *ClusterStatusHRS) String() string {
+	if hrs == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d/%d/%d", hrs.Height, hrs.Round, hrs.Step)
+}
+
+// less returns true if the sign state is before the other one
+func (hrs ClusterStatusHRS) less(other ClusterStatusHRS) bool {
+	if hrs.Height != other.Height {
+		return hrs.Height < other.Height
+	}
+	if hrs.Round != other.Round {
+		return hrs.Round < other.Round
+	}
+	return hrs.Step < other.Step
+}
+
+// ClusterStatusPeer is the latency and clock skew a cosigner sees to one of its peers
+type ClusterStatusPeer struct {
+	ShareID   int    `json:"share-id"`
+	Address   string `json:"address"`
+	Latency   string `json:"latency,omitempty"`
+	ClockSkew string `json:"clock-skew,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+// ClusterStatusNode is the status reported by a single cosigner
+type ClusterStatusNode struct {
+	Address        string              `json:"address"`
+	ShareID        int                 `json:"share-id,omitempty"`
+	Witness        bool                `json:"witness,omitempty"`
+	Version        string              `json:"version,omitempty"`
+	RaftState      string              `json:"raft-state,omitempty"`
+	Term           uint64              `json:"term"`
+	CommitIndex    uint64              `json:"commit-index"`
+	AppliedIndex   uint64              `json:"applied-index"`
+	LastContact    string              `json:"last-contact,omitempty"`
+	Sentries       int                 `json:"sentries"`
+	Paused         bool                `json:"paused,omitempty"`
+	LastSignState  *ClusterStatusHRS   `json:"last-sign-state,omitempty"`
+	ShareSignState *ClusterStatusHRS   `json:"share-sign-state,omitempty"`
+	Peers          []ClusterStatusPeer `json:"peers,omitempty"`
+	Error          string              `json:"error,omitempty"`
+}
+
+func clusterStatusCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "show the status of every cosigner in the cluster",
+		Long: "show the status of every cosigner in the cluster.\n\n" +
+			"Every configured cosigner, including this one, is asked for its raft state, last signed\n" +
+			"height/round/step of the privval and share sign state, version, connected sentries and latency to its peers.",
+		Example: `horcrux cluster status
+horcrux cluster status --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if config.Config.CosignerConfig == nil {
+				return fmt.Errorf("cosigner configuration is not present in config file")
+			}
+
+			asJSON, _ := cmd.Flags().GetBool("json")
+
+			// silence usage after all input has been validated
+			cmd.SilenceUsage = true
+
+			nodes := getClusterStatus()
+
+			if asJSON {
+				bz, err := json.MarshalIndent(nodes, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Println(string(bz))
+				return nil
+			}
+
+			printClusterStatus(nodes)
+			return nil
+		},
+	}
+	cmd.Flags().Bool("json", false, "print the cluster status as JSON")
+	return cmd
+}
+
+// getClusterStatus requests the status of every configured cosigner, including this one, and the witnesses
+func getClusterStatus() []ClusterStatusNode {
+	addresses := []string{config.Config.CosignerConfig.P2PListen}
+	for _, peer := range config.Config.CosignerConfig.Peers {
+		addresses = append(addresses, peer.P2PAddr)
+	}
+	for _, witness := range config.Config.CosignerConfig.Witnesses {
+		addresses = append(addresses, witness.P2PAddr)
+	}
+
+	nodes := make([]ClusterStatusNode, len(addresses))
+	var wg sync.WaitGroup
+	for i, address := range addresses {
+		wg.Add(1)
+		go func(i int, address string) {
+			defer wg.Done()
+			nodes[i] = getCosignerStatus(address)
+		}(i, address)
+	}
+	wg.Wait()
+	return nodes
+}
+
+// getCosignerStatus requests the status of the cosigner at the p2p address.
+// Errors are reported in the returned status so that unreachable cosigners still show up.
+func getCosignerStatus(address string) ClusterStatusNode {
+	node := ClusterStatusNode{Address: address}
+
+	grpcAddress, err := client.SanitizeAddress(address)
+	if err != nil {
+		node.Error = err.Error()
+		return node
+	}
+	conn, err := grpc.Dial(grpcAddress, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		node.Error = err.Error()
+		return node
+	}
+	defer conn.Close()
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancelFunc()
+
+	res, err := proto.NewCosignerGRPCClient(conn).GetStatus(ctx, &proto.CosignerGRPCGetStatusRequest{})
+	if err != nil {
+		node.Error = err.Error()
+		return node
+	}
+
+	node.ShareID = int(res.ShareID)
+	node.Witness = res.Witness
+	node.Version = res.Version
+	node.RaftState = res.RaftState
+	node.Term = res.Term
+	node.CommitIndex = res.CommitIndex
+	node.AppliedIndex = res.AppliedIndex
+	node.Sentries = int(res.Sentries)
+	node.Paused = res.Paused
+	node.LastSignState = clusterStatusHRSFromProto(res.LastSignState)
+	node.ShareSignState = clusterStatusHRSFromProto(res.ShareSignState)
+
+	switch {
+	case res.RaftState == "Leaderless":
+	case res.LastContact < 0:
+		node.LastContact = "never"
+	case res.LastContact == 0:
+		node.LastContact = "0s"
+	default:
+		node.LastContact = time.Duration(res.LastContact).Round(time.Millisecond).String()
+	}
+
+	for _, peer := range res.Peers {
+		p := ClusterStatusPeer{
+			ShareID: int(peer.ShareID),
+			Address: peer.Address,
+			Error:   peer.Error,
+		}
+		if peer.Error == "" {
+			p.Latency = time.Duration(peer.Latency).Round(time.Microsecond).String()
+			p.ClockSkew = time.Duration(peer.ClockSkew).Round(time.Microsecond).String()
+		}
+		node.Peers = append(node.Peers, p)
+	}
+	sort.Slice(node.Peers, func(i, j int) bool { return node.Peers[i].ShareID < node.Peers[j].ShareID })
+
+	return node
+}
+
+func clusterStatusHRSFromProto(hrs *proto.HRST) *ClusterStatusHRS {
+	if hrs == nil {
+		return nil
+	}
+	return &ClusterStatusHRS{Height: hrs.Height, Round: hrs.Round, Step: hrs.Step}
+}
+
+func printClusterStatus(nodes []ClusterStatusNode) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tADDRESS\tVERSION\tRAFT STATE\tTERM\tCOMMIT\tAPPLIED\tLAST CONTACT\tSENTRIES\t"+
+		"PRIVVAL HRS\tSHARE HRS\tPEER LATENCY\tPEER CLOCK SKEW")
+	for _, node := range nodes {
+		if node.Error != "" {
+			fmt.Fprintf(w, "-\t%s\terror: %s\n", node.Address, node.Error)
+			continue
+		}
+		peers := make([]string, len(node.Peers))
+		skews := make([]string, len(node.Peers))
+		for i, peer := range node.Peers {
+			if peer.Error != "" {
+				peers[i] = fmt.Sprintf("%d:unreachable", peer.ShareID)
+				skews[i] = fmt.Sprintf("%d:-", peer.ShareID)
+			} else {
+				peers[i] = fmt.Sprintf("%d:%s", peer.ShareID, peer.Latency)
+				skews[i] = fmt.Sprintf("%d:%s", peer.ShareID, peer.ClockSkew)
+			}
+		}
+		lastContact := node.LastContact
+		if lastContact == "" {
+			lastContact = "-"
+		}
+		id := fmt.Sprint(node.ShareID)
+		if node.Witness {
+			id += " (witness)"
+		}
+		if node.Paused {
+			id += " (paused)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\t%d\t%s\t%d\t%s\t%s\t%s\t%s\n",
+			id, node.Address, node.Version, node.RaftState, node.Term, node.CommitIndex,
+			node.AppliedIndex, lastContact, node.Sentries, node.LastSignState, node.ShareSignState,
+			strings.Join(peers, ","), strings.Join(skews, ","))
+	}
+	w.Flush()
+}
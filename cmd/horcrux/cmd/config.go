@@ -14,6 +14,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/strangelove-ventures/horcrux/client"
 	"github.com/strangelove-ventures/horcrux/signer"
+	tmlog "github.com/tendermint/tendermint/libs/log"
 	"gopkg.in/yaml.v2"
 )
 
@@ -30,6 +31,10 @@ func init() {
 	chainIDCmd.AddCommand(setChainIDCmd())
 	configCmd.AddCommand(chainIDCmd)
 
+	upgradeCmd.AddCommand(setUpgradeCmd())
+	upgradeCmd.AddCommand(removeUpgradeCmd())
+	configCmd.AddCommand(upgradeCmd)
+
 	configCmd.AddCommand(initCmd())
 	rootCmd.AddCommand(configCmd)
 }
@@ -76,15 +81,42 @@ func initCmd() *cobra.Command {
 				keyFile = &keyFileFlag
 			}
 			debugAddr, _ := cmdFlags.GetString("debug-addr")
+			drainTimeout, _ := cmdFlags.GetString("drain-timeout")
+			var doubleSignCheck *DoubleSignCheckConfig
+			if rpcAddr, _ := cmdFlags.GetString("double-sign-check-rpc"); rpcAddr != "" {
+				heights, _ := cmdFlags.GetInt64("double-sign-check-heights")
+				action, _ := cmdFlags.GetString("double-sign-check-action")
+				doubleSignCheck = &DoubleSignCheckConfig{RPCAddr: rpcAddr, Heights: heights, Action: action}
+			}
 			if cs {
 				// Cosigner Config
 				p, _ := cmdFlags.GetString("peers")
 				threshold, _ := cmdFlags.GetInt("threshold")
 				timeout, _ := cmdFlags.GetString("timeout")
+				leaderless, _ := cmdFlags.GetBool("leaderless")
+				witnessID, _ := cmdFlags.GetInt("witness-id")
+				autoLeaderPlacement, _ := cmdFlags.GetBool("auto-leader-placement")
+				leaderPriority, _ := cmdFlags.GetInt("leader-priority")
+				readinessTimeout, _ := cmdFlags.GetString("readiness-timeout")
+				maxClockSkew, _ := cmdFlags.GetString("max-clock-skew")
+				peerClockSkewWarning, _ := cmdFlags.GetString("peer-clock-skew-warning")
+				adminListen, _ := cmdFlags.GetString("admin-listen")
 				peers, err := peersFromFlag(p)
 				if err != nil {
 					return err
 				}
+				var witnesses []WitnessPeer
+				if w, _ := cmdFlags.GetString("witnesses"); w != "" {
+					witnesses, err = witnessesFromFlag(w)
+					if err != nil {
+						return err
+					}
+				}
+				shares := len(peers) + 1
+				if witnessID != 0 {
+					// witnesses hold no share
+					shares = len(peers)
+				}
 
 				listen, _ := cmdFlags.GetString("listen")
 				if listen == "" {
@@ -106,14 +138,28 @@ func initCmd() *cobra.Command {
 					PrivValKeyFile: keyFile,
 					ChainID:        cid,
 					CosignerConfig: &CosignerConfig{
-						Threshold: threshold,
-						Shares:    len(peers) + 1,
-						P2PListen: listen,
-						Peers:     peers,
-						Timeout:   timeout,
+						Threshold:  threshold,
+						Shares:     shares,
+						P2PListen:  listen,
+						Peers:      peers,
+						Timeout:    timeout,
+						Leaderless: leaderless,
+						WitnessID:  witnessID,
+						Witnesses:  witnesses,
+
+						AutoLeaderPlacement: autoLeaderPlacement,
+						LeaderPriority:      leaderPriority,
+						ReadinessTimeout:    readinessTimeout,
+
+						MaxClockSkew:         maxClockSkew,
+						PeerClockSkewWarning: peerClockSkewWarning,
+
+						AdminListen: adminListen,
 					},
-					ChainNodes: cn,
-					DebugAddr:  debugAddr,
+					ChainNodes:      cn,
+					DebugAddr:       debugAddr,
+					DrainTimeout:    drainTimeout,
+					DoubleSignCheck: doubleSignCheck,
 				}
 				if err = validateCosignerConfig(cfg); err != nil {
 					return err
@@ -124,10 +170,12 @@ func initCmd() *cobra.Command {
 					return fmt.Errorf("must input at least one node")
 				}
 				cfg = DiskConfig{
-					PrivValKeyFile: keyFile,
-					ChainID:        cid,
-					ChainNodes:     cn,
-					DebugAddr:      debugAddr,
+					PrivValKeyFile:  keyFile,
+					ChainID:         cid,
+					ChainNodes:      cn,
+					DebugAddr:       debugAddr,
+					DrainTimeout:    drainTimeout,
+					DoubleSignCheck: doubleSignCheck,
 				}
 				if err = validateSingleSignerConfig(cfg); err != nil {
 					return err
@@ -153,7 +201,7 @@ func initCmd() *cobra.Command {
 			}
 
 			// if node is a cosigner initialize state/{chainid}_priv_validator_state.json file
-			if cs {
+			if cs && cfg.CosignerConfig.WitnessID == 0 {
 				if _, err = signer.LoadOrCreateSignState(config.shareStateFile(cid)); err != nil {
 					return err
 				}
@@ -165,7 +213,7 @@ func initCmd() *cobra.Command {
 	}
 	cmd.Flags().BoolP("cosigner", "c", false, "set to initialize a cosigner node, requires --peers and --threshold")
 	cmd.Flags().StringP("peers", "p", "", "cosigner peer addresses in format tcp://{addr}:{port}|{share-id} \n"+
-		"(i.e. \"tcp://node-1:2222|2,tcp://node-2:2222|3\")")
+		"or tcp://{addr}:{port}|{share-id}|{leader-priority} (i.e. \"tcp://node-1:2222|2,tcp://node-2:2222|3|1\")")
 	cmd.Flags().IntP("threshold", "t", 0, "indicate number of signatures required for threshold signature")
 	cmd.Flags().StringP("listen", "l", "", "listen address of the signer")
 	cmd.Flags().StringP("debug-addr", "d", "", "listen address for Debug and Prometheus metrics in format localhost:8543")
@@ -173,6 +221,31 @@ func initCmd() *cobra.Command {
 		"priv val key file path (full key for single signer, or key share for cosigner)")
 	cmd.Flags().String("timeout", "1500ms", "configure cosigner rpc server timeout value, \n"+
 		"accepts valid duration strings for Go's time.ParseDuration() e.g. 1s, 1000ms, 1.5m")
+	cmd.Flags().Bool("leaderless", false, "set to let every cosigner coordinate the signing rounds \n"+
+		"for its own sentries, without a raft leader")
+	cmd.Flags().Int("witness-id", 0, "set to initialize a witness node with the given raft ID, \n"+
+		"which holds no key share and only votes in raft elections. Must be greater than the number of shares")
+	cmd.Flags().String("witnesses", "", "witness node addresses in format tcp://{addr}:{port}|{witness-id} \n"+
+		"(i.e. \"tcp://witness-1:2222|4\")")
+	cmd.Flags().Bool("auto-leader-placement", false, "set to move the raft leadership to the cosigner \n"+
+		"that is expected to sign the fastest, based on sentry connectivity and cosigner latencies")
+	cmd.Flags().Int("leader-priority", 0, "raft leader priority of this cosigner. The leader steps down for a \n"+
+		"healthy cosigner with a higher priority, lower priority cosigners campaign later")
+	cmd.Flags().String("readiness-timeout", "", "configure how long a cosigner waits on startup for a raft leader, \n"+
+		"reachable peers and an up to date sign state before connecting to sentries (default 1m)")
+	cmd.Flags().String("max-clock-skew", "", "refuse to sign votes and proposals with a timestamp further ahead \n"+
+		"of the local clock than this duration, e.g. 5s. Not checked if empty")
+	cmd.Flags().String("peer-clock-skew-warning", "", "warn when the clock of a peer cosigner is off by more \n"+
+		"than this duration (default 500ms)")
+	cmd.Flags().String("admin-listen", "", "listen address of the admin API of the cosigner, \n"+
+		"unix:///path/to/socket or tcp://127.0.0.1:{port} (default unix://{home}/admin.sock)")
+	cmd.Flags().String("drain-timeout", "", "configure how long to wait on shutdown for sign requests in progress \n"+
+		"and the raft leadership transfer, accepts valid duration strings e.g. 5s (default 10s)")
+	cmd.Flags().String("double-sign-check-rpc", "", "CometBFT RPC address of a chain node, i.e. http://sentry-1:26657. \n"+
+		"If set, the most recent commits are checked on startup for signatures above the local sign state")
+	cmd.Flags().Int64("double-sign-check-heights", 10, "number of most recent blocks checked for signatures on startup")
+	cmd.Flags().String("double-sign-check-action", doubleSignCheckHalt, "action when the validator signed \n"+
+		"a commit above the local sign state: halt to refuse to start, fast-forward to move the sign state past it")
 	cmd.Flags().BoolP("overwrite", "o", false, "set to overwrite an existing config.yaml")
 	return cmd
 }
@@ -187,7 +260,20 @@ func validateSingleSignerConfig(cfg DiskConfig) error {
 	if err := validateChainNodes(cfg.ChainNodes); err != nil {
 		return err
 	}
-	return nil
+	if _, err := cfg.ShutdownDrainTimeout(); err != nil {
+		return fmt.Errorf("%s is not a valid duration string for --drain-timeout", cfg.DrainTimeout)
+	}
+	if err := validateDoubleSignCheck(cfg.DoubleSignCheck); err != nil {
+		return err
+	}
+	if cfg.Upgrade != nil {
+		return fmt.Errorf("upgrade plans are only supported for cosigners")
+	}
+	if cfg.SignStateBackend == signStateBackendBoltDB {
+		// the sign state of a single signer is managed by the CometBFT file privval
+		return fmt.Errorf("the %s sign state backend is only supported for cosigners", signStateBackendBoltDB)
+	}
+	return validateAlerting(cfg.Alerting)
 }
 
 func validateCosignerConfig(cfg DiskConfig) error {
@@ -210,16 +296,67 @@ func validateCosignerConfig(cfg DiskConfig) error {
 	if err != nil {
 		return fmt.Errorf("%s is not a valid duration string for --timeout ", cfg.CosignerConfig.Timeout)
 	}
+	if _, err := cfg.CosignerConfig.SentryReadinessTimeout(); err != nil {
+		return fmt.Errorf("%s is not a valid duration string for --readiness-timeout", cfg.CosignerConfig.ReadinessTimeout)
+	}
+	if _, err := cfg.CosignerConfig.SignMaxClockSkew(); err != nil {
+		return fmt.Errorf("%s is not a valid duration string for --max-clock-skew", cfg.CosignerConfig.MaxClockSkew)
+	}
+	if _, err := cfg.CosignerConfig.PeerClockSkewLimit(); err != nil {
+		return fmt.Errorf("%s is not a valid duration string for --peer-clock-skew-warning",
+			cfg.CosignerConfig.PeerClockSkewWarning)
+	}
 	if _, err := url.Parse(cfg.CosignerConfig.P2PListen); err != nil {
 		return fmt.Errorf("failed to parse p2p listen address")
 	}
-	if err := validateCosignerPeers(cfg.CosignerConfig.Peers, cfg.CosignerConfig.Shares); err != nil {
+	if cfg.CosignerConfig.AdminListen != "" {
+		if _, _, err := signer.ParseAdminAddress(cfg.CosignerConfig.AdminListen); err != nil {
+			return err
+		}
+	}
+	cosigners := len(cfg.CosignerConfig.Peers) + 1
+	if cfg.CosignerConfig.WitnessID != 0 {
+		// witnesses hold no share, so every cosigner with a share is one of their peers
+		cosigners--
+		if err := validatePeerShareIDs(cfg.CosignerConfig.Peers, cfg.CosignerConfig.Shares); err != nil {
+			return err
+		}
+		if cosigners > cfg.CosignerConfig.Shares {
+			return fmt.Errorf("incorrect number of peers. expected at most %d peers for %d shares",
+				cfg.CosignerConfig.Shares, cfg.CosignerConfig.Shares)
+		}
+	} else if err := validateCosignerPeers(cfg.CosignerConfig.Peers, cfg.CosignerConfig.Shares); err != nil {
+		return err
+	}
+	if cosigners < cfg.CosignerConfig.Threshold {
+		return fmt.Errorf("number of cosigners (%d) must be greater or equal to threshold (%d)",
+			cosigners, cfg.CosignerConfig.Threshold)
+	}
+	if err := validateWitnesses(cfg.CosignerConfig); err != nil {
+		return err
+	}
+	if cfg.CosignerConfig.AutoLeaderPlacement && cfg.CosignerConfig.Leaderless {
+		return fmt.Errorf("automatic leader placement can not be used in leaderless mode, there is no raft leader")
+	}
+	if err := validateLeaderPriorities(cfg.CosignerConfig); err != nil {
 		return err
 	}
 	if err := validateChainNodes(cfg.ChainNodes); err != nil {
 		return err
 	}
-	return nil
+	if _, err := cfg.ShutdownDrainTimeout(); err != nil {
+		return fmt.Errorf("%s is not a valid duration string for --drain-timeout", cfg.DrainTimeout)
+	}
+	if err := validateDoubleSignCheck(cfg.DoubleSignCheck); err != nil {
+		return err
+	}
+	if err := validateSignStateBackend(cfg.SignStateBackend); err != nil {
+		return err
+	}
+	if err := validateUpgrade(cfg.ChainID, cfg.Upgrade); err != nil {
+		return err
+	}
+	return validateAlerting(cfg.Alerting)
 }
 
 var nodesCmd = &cobra.Command{
@@ -253,10 +390,16 @@ func addNodesCmd() *cobra.Command {
 			// silence usage after all input has been validated
 			cmd.SilenceUsage = true
 
+			action := newAdminAction(cmd, args)
+			_ = action.SetBefore(config.Config.ChainNodes)
+
 			config.Config.ChainNodes = diff
 			if err := config.writeConfigFile(); err != nil {
 				return err
 			}
+
+			_ = action.SetAfter(config.Config.ChainNodes)
+			recordAdminAction(cmd, action)
 			return nil
 		},
 	}
@@ -289,10 +432,16 @@ func removeNodesCmd() *cobra.Command {
 			// silence usage after all input has been validated
 			cmd.SilenceUsage = true
 
+			action := newAdminAction(cmd, args)
+			_ = action.SetBefore(config.Config.ChainNodes)
+
 			config.Config.ChainNodes = diff
 			if err := config.writeConfigFile(); err != nil {
 				return err
 			}
+
+			_ = action.SetAfter(config.Config.ChainNodes)
+			recordAdminAction(cmd, action)
 			return nil
 		},
 	}
@@ -347,10 +496,16 @@ func addPeersCmd() *cobra.Command {
 			// silence usage after all input has been validated
 			cmd.SilenceUsage = true
 
+			action := newAdminAction(cmd, args)
+			_ = action.SetBefore(config.Config.CosignerConfig.Peers)
+
 			config.Config.CosignerConfig.Peers = diff
 			if err := config.writeConfigFile(); err != nil {
 				return err
 			}
+
+			_ = action.SetAfter(config.Config.CosignerConfig.Peers)
+			recordAdminAction(cmd, action)
 			return nil
 		},
 	}
@@ -394,10 +549,16 @@ func removePeersCmd() *cobra.Command {
 			// silence usage after all input has been validated
 			cmd.SilenceUsage = true
 
+			action := newAdminAction(cmd, args)
+			_ = action.SetBefore(config.Config.CosignerConfig.Peers)
+
 			config.Config.CosignerConfig.Peers = diff
 			if err := config.writeConfigFile(); err != nil {
 				return err
 			}
+
+			_ = action.SetAfter(config.Config.CosignerConfig.Peers)
+			recordAdminAction(cmd, action)
 			return nil
 		},
 	}
@@ -488,6 +649,82 @@ func setChainIDCmd() *cobra.Command {
 			if err = config.writeConfigFile(); err != nil {
 				return err
 			}
+
+			action := newAdminAction(cmd, args)
+			_ = action.SetBefore(oldChainID)
+			_ = action.SetAfter(newChainID)
+			recordAdminAction(cmd, action)
+			return nil
+		},
+	}
+}
+
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "Commands to plan the upgrade of the chain to a new chain ID",
+}
+
+func setUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set [new-chain-id]",
+		Short: "plan the upgrade to a new chain ID",
+		Long: "plan the upgrade to a new chain ID.\n\n" +
+			"Nothing above the halt height is signed for the current chain. Once the sentries ask for\n" +
+			"a block above the halt height, all cosigners switch to the new chain ID with a sign state\n" +
+			"starting at the start height. Apply it to a running cosigner with horcrux admin reload.",
+		Example:      `horcrux config upgrade set cosmoshub-5 --halt-height 1500000`,
+		SilenceUsage: true,
+		Args:         cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			haltHeight, _ := cmd.Flags().GetInt64("halt-height")
+			startHeight, _ := cmd.Flags().GetInt64("start-height")
+			upgrade := &UpgradeConfig{
+				HaltHeight:  haltHeight,
+				ChainID:     args[0],
+				StartHeight: startHeight,
+			}
+			if err := validateUpgrade(config.Config.ChainID, upgrade); err != nil {
+				return err
+			}
+
+			before := config.Config.Upgrade
+			config.Config.Upgrade = upgrade
+			if err = config.writeConfigFile(); err != nil {
+				return err
+			}
+
+			action := newAdminAction(cmd, args)
+			_ = action.SetBefore(before)
+			_ = action.SetAfter(upgrade)
+			recordAdminAction(cmd, action)
+			return nil
+		},
+	}
+	cmd.Flags().Int64("halt-height", 0, "last height signed for the current chain")
+	cmd.Flags().Int64("start-height", 0, "first height of the new chain (default halt height + 1)")
+	_ = cmd.MarkFlagRequired("halt-height")
+	return cmd
+}
+
+func removeUpgradeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:          "remove",
+		Short:        "cancel the planned upgrade to a new chain ID",
+		SilenceUsage: true,
+		Args:         cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			before := config.Config.Upgrade
+			if before == nil {
+				return fmt.Errorf("no upgrade is planned")
+			}
+			config.Config.Upgrade = nil
+			if err = config.writeConfigFile(); err != nil {
+				return err
+			}
+
+			action := newAdminAction(cmd, args)
+			_ = action.SetBefore(before)
+			recordAdminAction(cmd, action)
 			return nil
 		},
 	}
@@ -500,6 +737,222 @@ type DiskConfig struct {
 	CosignerConfig *CosignerConfig `json:"cosigner,omitempty" yaml:"cosigner,omitempty"`
 	ChainNodes     []ChainNode     `json:"chain-nodes,omitempty" yaml:"chain-nodes,omitempty"`
 	DebugAddr      string          `json:"debug-addr,omitempty" yaml:"debug-addr,omitempty"`
+	DrainTimeout   string          `json:"drain-timeout,omitempty" yaml:"drain-timeout,omitempty"`
+
+	DoubleSignCheck *DoubleSignCheckConfig `json:"double-sign-check,omitempty" yaml:"double-sign-check,omitempty"`
+	Alerting        *AlertingConfig        `json:"alerting,omitempty" yaml:"alerting,omitempty"`
+	Upgrade         *UpgradeConfig         `json:"upgrade,omitempty" yaml:"upgrade,omitempty"`
+
+	// json (default) or boltdb
+	SignStateBackend string `json:"sign-state-backend,omitempty" yaml:"sign-state-backend,omitempty"`
+}
+
+const (
+	doubleSignCheckHalt        = "halt"
+	doubleSignCheckFastForward = "fast-forward"
+)
+
+// DoubleSignCheckConfig configures the check on startup for commits of the most recent blocks
+// that the validator signed above the local sign state.
+type DoubleSignCheckConfig struct {
+	RPCAddr string `json:"rpc-addr" yaml:"rpc-addr"`
+	Heights int64  `json:"heights" yaml:"heights"`
+	// halt (default) refuses to start, fast-forward moves the sign state past the signed commits
+	Action string `json:"action,omitempty" yaml:"action,omitempty"`
+}
+
+func validateDoubleSignCheck(cfg *DoubleSignCheckConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	if _, err := url.Parse(cfg.RPCAddr); err != nil || cfg.RPCAddr == "" {
+		return fmt.Errorf("invalid double sign check RPC address %q", cfg.RPCAddr)
+	}
+	if cfg.Heights <= 0 {
+		return fmt.Errorf("double sign check heights (%d) must be greater than 0", cfg.Heights)
+	}
+	switch cfg.Action {
+	case "", doubleSignCheckHalt, doubleSignCheckFastForward:
+	default:
+		return fmt.Errorf("double sign check action %q must be %s or %s",
+			cfg.Action, doubleSignCheckHalt, doubleSignCheckFastForward)
+	}
+	return nil
+}
+
+const (
+	signStateBackendJSON   = "json"
+	signStateBackendBoltDB = "boltdb"
+)
+
+func validateSignStateBackend(backend string) error {
+	switch backend {
+	case "", signStateBackendJSON, signStateBackendBoltDB:
+		return nil
+	}
+	return fmt.Errorf("sign state backend %q must be %s or %s", backend, signStateBackendJSON, signStateBackendBoltDB)
+}
+
+// signStateFileExt returns the file extension of the sign states for the sign state backend
+func signStateFileExt(backend string) string {
+	if backend == signStateBackendBoltDB {
+		return signer.BoltSignStateExt
+	}
+	return ".json"
+}
+
+// UpgradeConfig plans the upgrade of the chain to a new chain ID. Nothing above the halt height
+// is signed for the current chain, then all cosigners switch to the new chain ID.
+type UpgradeConfig struct {
+	HaltHeight int64  `json:"halt-height" yaml:"halt-height"`
+	ChainID    string `json:"chain-id" yaml:"chain-id"`
+	// first height of the new chain, by default the height after the halt height
+	StartHeight int64 `json:"start-height,omitempty" yaml:"start-height,omitempty"`
+}
+
+func validateUpgrade(chainID string, cfg *UpgradeConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	if cfg.HaltHeight <= 0 {
+		return fmt.Errorf("upgrade halt height (%d) must be greater than 0", cfg.HaltHeight)
+	}
+	if cfg.ChainID == "" {
+		return fmt.Errorf("upgrade chain-id cannot be empty")
+	}
+	if cfg.ChainID == chainID {
+		return fmt.Errorf("upgrade chain-id must differ from the current chain-id %s", chainID)
+	}
+	if cfg.StartHeight < 0 {
+		return fmt.Errorf("upgrade start height (%d) must not be negative", cfg.StartHeight)
+	}
+	return nil
+}
+
+// UpgradePlan returns the planned upgrade of the chain, nil if there is none
+func (c DiskConfig) UpgradePlan() *signer.UpgradePlan {
+	if c.Upgrade == nil {
+		return nil
+	}
+	startHeight := c.Upgrade.StartHeight
+	if startHeight == 0 {
+		startHeight = c.Upgrade.HaltHeight + 1
+	}
+	return &signer.UpgradePlan{
+		ChainID:     c.ChainID,
+		HaltHeight:  c.Upgrade.HaltHeight,
+		NewChainID:  c.Upgrade.ChainID,
+		StartHeight: startHeight,
+	}
+}
+
+// AlertingConfig configures the notifiers that alerts are sent to
+type AlertingConfig struct {
+	Notifiers []AlertNotifierConfig `json:"notifiers" yaml:"notifiers"`
+
+	// minimum time between two alerts for the same event, default 5m
+	RateLimit string `json:"rate-limit,omitempty" yaml:"rate-limit,omitempty"`
+
+	// number of consecutive missed precommits or prevotes that fires an alert, default 3
+	MissedPrecommits int64 `json:"missed-precommits,omitempty" yaml:"missed-precommits,omitempty"`
+	MissedPrevotes   int64 `json:"missed-prevotes,omitempty" yaml:"missed-prevotes,omitempty"`
+
+	// events that fire alerts, all events if empty
+	Events []string `json:"events,omitempty" yaml:"events,omitempty"`
+}
+
+// AlertNotifierConfig configures a notifier of type webhook, slack or pagerduty
+type AlertNotifierConfig struct {
+	Type       string `json:"type" yaml:"type"`
+	URL        string `json:"url,omitempty" yaml:"url,omitempty"`
+	RoutingKey string `json:"routing-key,omitempty" yaml:"routing-key,omitempty"`
+}
+
+// Alerter returns the alerter for the config, with the given source as the name of this signer node
+func (c *AlertingConfig) Alerter(logger tmlog.Logger, source string) (*signer.Alerter, error) {
+	if len(c.Notifiers) == 0 {
+		return nil, errors.New("alerting requires at least one notifier")
+	}
+	notifiers := make([]signer.Notifier, len(c.Notifiers))
+	for i, n := range c.Notifiers {
+		notifier, err := signer.NewNotifier(n.Type, n.URL, n.RoutingKey)
+		if err != nil {
+			return nil, err
+		}
+		if n.URL != "" {
+			if _, err := url.Parse(n.URL); err != nil {
+				return nil, fmt.Errorf("invalid %s notifier url", n.Type)
+			}
+		}
+		notifiers[i] = notifier
+	}
+
+	alerter := signer.NewAlerter(logger, source, notifiers)
+	if c.RateLimit != "" {
+		rateLimit, err := time.ParseDuration(c.RateLimit)
+		if err != nil {
+			return nil, fmt.Errorf("%s is not a valid duration string for the alerting rate-limit", c.RateLimit)
+		}
+		alerter.RateLimit = rateLimit
+	}
+	if c.MissedPrecommits < 0 || c.MissedPrevotes < 0 {
+		return nil, errors.New("alerting missed-precommits and missed-prevotes must not be negative")
+	}
+	if c.MissedPrecommits > 0 {
+		alerter.MissedPrecommitsThreshold = c.MissedPrecommits
+	}
+	if c.MissedPrevotes > 0 {
+		alerter.MissedPrevotesThreshold = c.MissedPrevotes
+	}
+	if len(c.Events) > 0 {
+		alerter.Events = make(map[signer.AlertEvent]bool)
+		for _, event := range c.Events {
+			if !isAlertEvent(signer.AlertEvent(event)) {
+				return nil, fmt.Errorf("unknown alerting event %q", event)
+			}
+			alerter.Events[signer.AlertEvent(event)] = true
+		}
+	}
+	return alerter, nil
+}
+
+func isAlertEvent(event signer.AlertEvent) bool {
+	for _, e := range signer.AlertEvents {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// startAlerting sends the alerts of this process to the configured notifiers, if any.
+// The alerting config has been validated with the config.
+func startAlerting(logger tmlog.Logger) {
+	if config.Config.Alerting == nil {
+		return
+	}
+	source, err := os.Hostname()
+	if err != nil {
+		source = "horcrux"
+	}
+	alerter, _ := config.Config.Alerting.Alerter(logger, source)
+	signer.SetAlerter(alerter)
+}
+
+func validateAlerting(cfg *AlertingConfig) error {
+	if cfg == nil {
+		return nil
+	}
+	_, err := cfg.Alerter(tmlog.NewNopLogger(), "")
+	return err
+}
+
+// ShutdownDrainTimeout returns the time to wait for in-flight work on shutdown
+func (c *DiskConfig) ShutdownDrainTimeout() (time.Duration, error) {
+	if c.DrainTimeout == "" {
+		return signer.DefaultDrainTimeout, nil
+	}
+	return time.ParseDuration(c.DrainTimeout)
 }
 
 func (c *DiskConfig) Nodes() []signer.NodeConfig {
@@ -537,11 +990,46 @@ func (c *RuntimeConfig) keyFilePath(cosigner bool) string {
 }
 
 func (c RuntimeConfig) privValStateFile(chainID string) string {
-	return filepath.Join(c.StateDir, fmt.Sprintf("%s_priv_validator_state.json", chainID))
+	return filepath.Join(c.StateDir, chainID+"_priv_validator_state"+signStateFileExt(c.Config.SignStateBackend))
 }
 
 func (c RuntimeConfig) shareStateFile(chainID string) string {
-	return filepath.Join(c.StateDir, fmt.Sprintf("%s_share_sign_state.json", chainID))
+	return filepath.Join(c.StateDir, chainID+"_share_sign_state"+signStateFileExt(c.Config.SignStateBackend))
+}
+
+func (c RuntimeConfig) conflictingRequestsFile(chainID string) string {
+	return filepath.Join(c.StateDir, fmt.Sprintf("%s_conflicting_requests.jsonl", chainID))
+}
+
+func (c RuntimeConfig) auditLogFile(chainID string) string {
+	return filepath.Join(c.StateDir, fmt.Sprintf("%s_audit_log.jsonl", chainID))
+}
+
+// clusterPauseFile is not specific to the chain, a pause of the cluster may span a chain upgrade
+func (c RuntimeConfig) clusterPauseFile() string {
+	return filepath.Join(c.StateDir, "cluster_pause.json")
+}
+
+func (c RuntimeConfig) adminAuditFile() string {
+	return filepath.Join(c.StateDir, "admin_audit.jsonl")
+}
+
+// adminListenAddress returns the address of the admin API, by default a unix socket in the home directory
+func (c RuntimeConfig) adminListenAddress() string {
+	if c.Config.CosignerConfig != nil && c.Config.CosignerConfig.AdminListen != "" {
+		return c.Config.CosignerConfig.AdminListen
+	}
+	return "unix://" + filepath.Join(c.HomeDir, "admin.sock")
+}
+
+func (c RuntimeConfig) adminTokenFile() string {
+	return filepath.Join(c.HomeDir, "admin_token")
+}
+
+// auditKeyFile is outside the state directory, so that a copy of the state directory is not enough
+// to rewrite the audit log
+func (c RuntimeConfig) auditKeyFile() string {
+	return filepath.Join(c.HomeDir, "audit_key")
 }
 
 func (c RuntimeConfig) writeConfigFile() error {
@@ -549,11 +1037,49 @@ func (c RuntimeConfig) writeConfigFile() error {
 }
 
 type CosignerConfig struct {
-	Threshold int            `json:"threshold"   yaml:"threshold"`
-	Shares    int            `json:"shares" yaml:"shares"`
-	P2PListen string         `json:"p2p-listen"  yaml:"p2p-listen"`
-	Peers     []CosignerPeer `json:"peers"       yaml:"peers"`
-	Timeout   string         `json:"rpc-timeout" yaml:"rpc-timeout"`
+	Threshold  int            `json:"threshold"   yaml:"threshold"`
+	Shares     int            `json:"shares" yaml:"shares"`
+	P2PListen  string         `json:"p2p-listen"  yaml:"p2p-listen"`
+	Peers      []CosignerPeer `json:"peers"       yaml:"peers"`
+	Timeout    string         `json:"rpc-timeout" yaml:"rpc-timeout"`
+	Leaderless bool           `json:"leaderless,omitempty" yaml:"leaderless,omitempty"`
+	WitnessID  int            `json:"witness-id,omitempty" yaml:"witness-id,omitempty"`
+	Witnesses  []WitnessPeer  `json:"witnesses,omitempty" yaml:"witnesses,omitempty"`
+
+	AutoLeaderPlacement bool `json:"auto-leader-placement,omitempty" yaml:"auto-leader-placement,omitempty"`
+	LeaderPriority      int  `json:"leader-priority,omitempty" yaml:"leader-priority,omitempty"`
+
+	ReadinessTimeout string `json:"readiness-timeout,omitempty" yaml:"readiness-timeout,omitempty"`
+
+	MaxClockSkew         string `json:"max-clock-skew,omitempty" yaml:"max-clock-skew,omitempty"`
+	PeerClockSkewWarning string `json:"peer-clock-skew-warning,omitempty" yaml:"peer-clock-skew-warning,omitempty"`
+
+	AdminListen string `json:"admin-listen,omitempty" yaml:"admin-listen,omitempty"`
+}
+
+// SentryReadinessTimeout returns the time to wait for the cosigner to be ready before connecting to the sentries
+func (cfg *CosignerConfig) SentryReadinessTimeout() (time.Duration, error) {
+	if cfg.ReadinessTimeout == "" {
+		return signer.DefaultReadinessTimeout, nil
+	}
+	return time.ParseDuration(cfg.ReadinessTimeout)
+}
+
+// SignMaxClockSkew returns the maximum time the timestamp of a vote or proposal may be ahead of the
+// local clock, or zero if it is not checked
+func (cfg *CosignerConfig) SignMaxClockSkew() (time.Duration, error) {
+	if cfg.MaxClockSkew == "" {
+		return 0, nil
+	}
+	return time.ParseDuration(cfg.MaxClockSkew)
+}
+
+// PeerClockSkewLimit returns the clock skew to a peer cosigner above which a warning is logged
+func (cfg *CosignerConfig) PeerClockSkewLimit() (time.Duration, error) {
+	if cfg.PeerClockSkewWarning == "" {
+		return signer.DefaultPeerClockSkewWarning, nil
+	}
+	return time.ParseDuration(cfg.PeerClockSkewWarning)
 }
 
 func (cfg *CosignerConfig) LeaderElectMultiAddress() (string, error) {
@@ -572,12 +1098,113 @@ func (c *DiskConfig) CosignerPeers() (out []signer.CosignerConfig) {
 	return
 }
 
+// CosignerLeaderPriorities returns the leader priorities by share ID, including this cosigner.
+// Returns nil if no leader priorities are configured.
+func (c *DiskConfig) CosignerLeaderPriorities(ourID int) map[int]int {
+	priorities := map[int]int{ourID: c.CosignerConfig.LeaderPriority}
+	configured := c.CosignerConfig.LeaderPriority != 0
+	for _, p := range c.CosignerConfig.Peers {
+		priorities[p.ShareID] = p.LeaderPriority
+		configured = configured || p.LeaderPriority != 0
+	}
+	if !configured {
+		return nil
+	}
+	return priorities
+}
+
+func (c *DiskConfig) CosignerWitnesses() (out []signer.CosignerConfig) {
+	for _, w := range c.CosignerConfig.Witnesses {
+		out = append(out, signer.CosignerConfig{ID: w.WitnessID, Address: w.P2PAddr})
+	}
+	return
+}
+
 type CosignerPeer struct {
-	ShareID int    `json:"share-id" yaml:"share-id"`
-	P2PAddr string `json:"p2p-addr" yaml:"p2p-addr"`
+	ShareID        int    `json:"share-id" yaml:"share-id"`
+	P2PAddr        string `json:"p2p-addr" yaml:"p2p-addr"`
+	LeaderPriority int    `json:"leader-priority,omitempty" yaml:"leader-priority,omitempty"`
+}
+
+// WitnessPeer is a raft voter that holds no key share. Witness IDs are used as raft
+// node IDs, so they must be greater than the number of shares.
+type WitnessPeer struct {
+	WitnessID int    `json:"witness-id" yaml:"witness-id"`
+	P2PAddr   string `json:"p2p-addr" yaml:"p2p-addr"`
+}
+
+func validateLeaderPriorities(cfg *CosignerConfig) error {
+	configured := cfg.LeaderPriority != 0
+	if cfg.LeaderPriority < 0 {
+		return fmt.Errorf("leader priority %d must not be negative", cfg.LeaderPriority)
+	}
+	for _, peer := range cfg.Peers {
+		if peer.LeaderPriority < 0 {
+			return fmt.Errorf("leader priority %d of peer %d must not be negative", peer.LeaderPriority, peer.ShareID)
+		}
+		configured = configured || peer.LeaderPriority != 0
+	}
+	if configured && cfg.Leaderless {
+		return fmt.Errorf("leader priorities can not be used in leaderless mode, there is no raft leader")
+	}
+	return nil
+}
+
+func validateWitnesses(cfg *CosignerConfig) error {
+	if len(cfg.Witnesses) == 0 && cfg.WitnessID == 0 {
+		return nil
+	}
+	if cfg.Leaderless {
+		return fmt.Errorf("witnesses can not be used in leaderless mode, there is no raft cluster")
+	}
+	if cfg.WitnessID != 0 && cfg.WitnessID <= cfg.Shares {
+		return fmt.Errorf("witness ID %d must be greater than the number of shares (%d)", cfg.WitnessID, cfg.Shares)
+	}
+	encountered := make(map[int]bool)
+	for _, witness := range cfg.Witnesses {
+		if witness.WitnessID <= cfg.Shares {
+			return fmt.Errorf("witness ID %d must be greater than the number of shares (%d)",
+				witness.WitnessID, cfg.Shares)
+		}
+		if encountered[witness.WitnessID] {
+			return fmt.Errorf("found duplicate witness ID %d", witness.WitnessID)
+		}
+		encountered[witness.WitnessID] = true
+		if _, err := url.Parse(witness.P2PAddr); err != nil {
+			return fmt.Errorf("failed to parse witness address %s: %w", witness.P2PAddr, err)
+		}
+	}
+	return nil
+}
+
+func witnessesFromFlag(witnesses string) (out []WitnessPeer, err error) {
+	peers, err := peersFromFlag(witnesses)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range peers {
+		out = append(out, WitnessPeer{WitnessID: p.ShareID, P2PAddr: p.P2PAddr})
+	}
+	return
 }
 
 func validateCosignerPeers(peers []CosignerPeer, shares int) error {
+	if err := validatePeerShareIDs(peers, shares); err != nil {
+		return err
+	}
+
+	// Check that at most {num-shares}-1 peers are in the peer list, assuming
+	// the remaining peer ID is the ID the local node is configured with.
+	// Fewer peers are allowed after cosigners have been removed from a running cluster.
+	if len(peers) > shares-1 {
+		return fmt.Errorf("incorrect number of peers. expected at most (%d shares - local node = %d peers)",
+			shares, shares-1)
+	}
+	return nil
+}
+
+// validatePeerShareIDs checks that the share IDs of the peers are unique and within the number of shares
+func validatePeerShareIDs(peers []CosignerPeer, shares int) error {
 	// Check IDs to make sure none are duplicated
 	if dupl := duplicatePeers(peers); len(dupl) != 0 {
 		return fmt.Errorf("found duplicate share IDs in args: %v", dupl)
@@ -590,13 +1217,6 @@ func validateCosignerPeers(peers []CosignerPeer, shares int) error {
 				peer.ShareID, shares)
 		}
 	}
-
-	// Check that exactly {num-shares}-1 peers are in the peer list, assuming
-	// the remaining peer ID is the ID the local node is configured with.
-	if len(peers) != shares-1 {
-		return fmt.Errorf("incorrect number of peers. expected (%d shares - local node = %d peers)",
-			shares, shares-1)
-	}
 	return nil
 }
 
@@ -606,7 +1226,7 @@ func duplicatePeers(peers []CosignerPeer) (duplicates []CosignerPeer) {
 		if _, found := encountered[peer.ShareID]; !found {
 			encountered[peer.ShareID] = peer.P2PAddr
 		} else {
-			duplicates = append(duplicates, CosignerPeer{peer.ShareID, peer.P2PAddr})
+			duplicates = append(duplicates, CosignerPeer{ShareID: peer.ShareID, P2PAddr: peer.P2PAddr})
 		}
 	}
 	return
@@ -615,14 +1235,22 @@ func duplicatePeers(peers []CosignerPeer) (duplicates []CosignerPeer) {
 func peersFromFlag(peers string) (out []CosignerPeer, err error) {
 	for _, p := range strings.Split(peers, ",") {
 		ps := strings.Split(p, "|")
-		if len(ps) != 2 {
+		if len(ps) != 2 && len(ps) != 3 {
 			return nil, fmt.Errorf("invalid peer string %s", p)
 		}
 		shareid, err := strconv.ParseInt(ps[1], 10, 64)
 		if err != nil {
 			return nil, err
 		}
-		out = append(out, CosignerPeer{ShareID: int(shareid), P2PAddr: ps[0]})
+		peer := CosignerPeer{ShareID: int(shareid), P2PAddr: ps[0]}
+		if len(ps) == 3 {
+			priority, err := strconv.ParseInt(ps[2], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid leader priority in peer string %s: %w", p, err)
+			}
+			peer.LeaderPriority = int(priority)
+		}
+		out = append(out, peer)
 	}
 	return
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/strangelove-ventures/horcrux/signer"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
 )
 
 const (
@@ -51,6 +52,76 @@ func TestConfigInitCmd(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "valid init with leader priorities",
+			home: tmpHome + "_valid_init_leader_priorities",
+			args: []string{
+				chainID,
+				"tcp://10.168.0.1:1234",
+				"-c",
+				"-p", "tcp://10.168.1.2:2222|2|2,tcp://10.168.1.3:2222|3",
+				"-t", "2",
+				"-l", "tcp://10.168.1.1:2222",
+				"--leader-priority", "2",
+			},
+			expectErr: false,
+		},
+		{
+			name: "negative leader priority",
+			home: tmpHome + "_negative_leader_priority",
+			args: []string{
+				chainID,
+				"tcp://10.168.0.1:1234",
+				"-c",
+				"-p", "tcp://10.168.1.2:2222|2|-1,tcp://10.168.1.3:2222|3",
+				"-t", "2",
+				"-l", "tcp://10.168.1.1:2222",
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid double sign check action",
+			home: tmpHome + "_invalid_double_sign_check_action",
+			args: []string{
+				chainID,
+				"tcp://10.168.0.1:1234",
+				"-c",
+				"-p", "tcp://10.168.1.2:2222|2,tcp://10.168.1.3:2222|3",
+				"-t", "2",
+				"-l", "tcp://10.168.1.1:2222",
+				"--double-sign-check-rpc", "http://10.168.0.1:26657",
+				"--double-sign-check-action", "ignore",
+			},
+			expectErr: true,
+		},
+		{
+			name: "valid init with admin listen",
+			home: tmpHome + "_valid_init_admin_listen",
+			args: []string{
+				chainID,
+				"tcp://10.168.0.1:1234",
+				"-c",
+				"-p", "tcp://10.168.1.2:2222|2,tcp://10.168.1.3:2222|3",
+				"-t", "2",
+				"-l", "tcp://10.168.1.1:2222",
+				"--admin-listen", "tcp://127.0.0.1:2223",
+			},
+			expectErr: false,
+		},
+		{
+			name: "admin listen not on localhost",
+			home: tmpHome + "_admin_listen_not_localhost",
+			args: []string{
+				chainID,
+				"tcp://10.168.0.1:1234",
+				"-c",
+				"-p", "tcp://10.168.1.2:2222|2,tcp://10.168.1.3:2222|3",
+				"-t", "2",
+				"-l", "tcp://10.168.1.1:2222",
+				"--admin-listen", "tcp://10.168.1.1:2223",
+			},
+			expectErr: true,
+		},
 		{
 			name: "invalid peer-nodes",
 			home: tmpHome + "_invalid_peer-nodes",
@@ -107,6 +178,98 @@ func TestConfigInitCmd(t *testing.T) {
 	}
 }
 
+func TestConfigInitWitness(t *testing.T) {
+	tmpHome := t.TempDir()
+	tcs := []struct {
+		name            string
+		home            string
+		args            []string
+		expectShares    int
+		expectWitnesses []WitnessPeer
+		expectErr       bool
+	}{
+		{
+			name: "valid witness",
+			home: tmpHome + "_valid_witness",
+			args: []string{
+				chainID,
+				"-c",
+				"-p", "tcp://10.168.1.1:2222|1,tcp://10.168.1.2:2222|2,tcp://10.168.1.3:2222|3",
+				"-t", "2",
+				"-l", "tcp://10.168.1.4:2222",
+				"--witness-id", "4",
+			},
+			expectShares: 3,
+			expectErr:    false,
+		},
+		{
+			name: "witness ID within shares",
+			home: tmpHome + "_witness_id_within_shares",
+			args: []string{
+				chainID,
+				"-c",
+				"-p", "tcp://10.168.1.1:2222|1,tcp://10.168.1.2:2222|2,tcp://10.168.1.3:2222|3",
+				"-t", "2",
+				"-l", "tcp://10.168.1.4:2222",
+				"--witness-id", "3",
+			},
+			expectErr: true,
+		},
+		{
+			name: "cosigner with witness",
+			home: tmpHome + "_cosigner_with_witness",
+			args: []string{
+				chainID,
+				"tcp://10.168.0.1:1234",
+				"-c",
+				"-p", "tcp://10.168.1.2:2222|2,tcp://10.168.1.3:2222|3",
+				"-t", "2",
+				"-l", "tcp://10.168.1.1:2222",
+				"--witnesses", "tcp://10.168.1.4:2222|4",
+			},
+			expectShares:    3,
+			expectWitnesses: []WitnessPeer{{WitnessID: 4, P2PAddr: "tcp://10.168.1.4:2222"}},
+			expectErr:       false,
+		},
+		{
+			name: "leaderless cosigner with witness",
+			home: tmpHome + "_leaderless_cosigner_with_witness",
+			args: []string{
+				chainID,
+				"tcp://10.168.0.1:1234",
+				"-c",
+				"-p", "tcp://10.168.1.2:2222|2,tcp://10.168.1.3:2222|3",
+				"-t", "2",
+				"-l", "tcp://10.168.1.1:2222",
+				"--witnesses", "tcp://10.168.1.4:2222|4",
+				"--leaderless",
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("HOME", tc.home)
+			err := os.MkdirAll(tc.home, 0777)
+			require.NoError(t, err)
+
+			cmd := initCmd()
+			cmd.SetOutput(io.Discard)
+			cmd.SetArgs(tc.args)
+			err = cmd.Execute()
+
+			if tc.expectErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.expectShares, config.Config.CosignerConfig.Shares)
+				require.Equal(t, tc.expectWitnesses, config.Config.CosignerConfig.Witnesses)
+			}
+		})
+	}
+}
+
 func TestConfigChainIDSetCmd(t *testing.T) {
 	t.Setenv("HOME", t.TempDir())
 
@@ -625,3 +788,129 @@ func TestSetShares(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAlerting(t *testing.T) {
+	require.NoError(t, validateAlerting(nil))
+
+	valid := &AlertingConfig{
+		Notifiers: []AlertNotifierConfig{
+			{Type: "slack", URL: "https://hooks.slack.com/services/T/B/X"},
+			{Type: "pagerduty", RoutingKey: "routing-key"},
+		},
+		RateLimit:        "1m",
+		MissedPrecommits: 5,
+		Events:           []string{"missed-precommits", "conflicting-request"},
+	}
+	require.NoError(t, validateAlerting(valid))
+
+	tcs := []struct {
+		name string
+		cfg  AlertingConfig
+	}{
+		{name: "no notifiers", cfg: AlertingConfig{}},
+		{name: "webhook without url", cfg: AlertingConfig{Notifiers: []AlertNotifierConfig{{Type: "webhook"}}}},
+		{name: "pagerduty without routing key", cfg: AlertingConfig{Notifiers: []AlertNotifierConfig{{Type: "pagerduty"}}}},
+		{name: "unknown notifier", cfg: AlertingConfig{Notifiers: []AlertNotifierConfig{{Type: "email", URL: "x"}}}},
+		{name: "invalid rate limit", cfg: AlertingConfig{Notifiers: valid.Notifiers, RateLimit: "often"}},
+		{name: "negative threshold", cfg: AlertingConfig{Notifiers: valid.Notifiers, MissedPrevotes: -1}},
+		{name: "unknown event", cfg: AlertingConfig{Notifiers: valid.Notifiers, Events: []string{"disk-full"}}},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.Error(t, validateAlerting(&tc.cfg))
+		})
+	}
+}
+
+func TestConfigUpgradeSetAndRemove(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	cmd := initCmd()
+	cmd.SetOutput(io.Discard)
+	cmd.SetArgs([]string{
+		chainID,
+		"tcp://10.168.0.1:1234",
+		"-c",
+		"-p", "tcp://10.168.1.2:2222|2,tcp://10.168.1.3:2222|3",
+		"-t", "2",
+		"-l", "tcp://10.168.1.1:2222",
+	})
+	require.NoError(t, cmd.Execute())
+
+	tcs := []struct {
+		name      string
+		args      []string
+		expectErr bool
+	}{
+		{name: "missing halt height", args: []string{"horcrux-2"}, expectErr: true},
+		{name: "same chain-id", args: []string{chainID, "--halt-height", "100"}, expectErr: true},
+		{name: "negative start height", args: []string{"horcrux-2", "--halt-height", "100", "--start-height", "-1"},
+			expectErr: true},
+		{name: "happy path", args: []string{"horcrux-2", "--halt-height", "100"}},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := setUpgradeCmd()
+			cmd.SetOutput(io.Discard)
+			cmd.SetArgs(tc.args)
+			err := cmd.Execute()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	require.Equal(t, &signer.UpgradePlan{
+		ChainID:     chainID,
+		HaltHeight:  100,
+		NewChainID:  "horcrux-2",
+		StartHeight: 101,
+	}, config.Config.UpgradePlan())
+	require.NoError(t, validateCosignerConfig(config.Config))
+
+	cmd = removeUpgradeCmd()
+	cmd.SetOutput(io.Discard)
+	cmd.SetArgs([]string{})
+	require.NoError(t, cmd.Execute())
+	require.Nil(t, config.Config.UpgradePlan())
+	require.Error(t, cmd.Execute())
+}
+
+func TestWriteCosignerPeers(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	cmd := initCmd()
+	cmd.SetOutput(io.Discard)
+	cmd.SetArgs([]string{
+		chainID,
+		"tcp://10.168.0.1:1234",
+		"-c",
+		"-p", "tcp://10.168.1.2:2222|2,tcp://10.168.1.3:2222|3",
+		"-t", "2",
+		"-l", "tcp://10.168.1.1:2222",
+	})
+	require.NoError(t, cmd.Execute())
+	config.Config.CosignerConfig.Peers[1].LeaderPriority = 5
+
+	// cosigner 2 was removed and cosigner 4 added at runtime
+	require.NoError(t, writeCosignerPeers(1, []signer.CosignerConfig{
+		{ID: 1, Address: "tcp://10.168.1.1:2222"},
+		{ID: 4, Address: "tcp://10.168.1.4:2222"},
+		{ID: 3, Address: "tcp://10.168.1.3:2222"},
+	}))
+
+	expected := []CosignerPeer{
+		{ShareID: 3, P2PAddr: "tcp://10.168.1.3:2222", LeaderPriority: 5},
+		{ShareID: 4, P2PAddr: "tcp://10.168.1.4:2222"},
+	}
+	require.Equal(t, expected, config.Config.CosignerConfig.Peers)
+
+	bz, err := os.ReadFile(config.ConfigFile)
+	require.NoError(t, err)
+	var written DiskConfig
+	require.NoError(t, yaml.Unmarshal(bz, &written))
+	require.Equal(t, expected, written.CosignerConfig.Peers)
+}
@@ -1,12 +1,15 @@
 package cmd
 
 import (
+	"context"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
 
@@ -114,10 +117,13 @@ func StartCosignerCmd() *cobra.Command {
 				return err
 			}
 
+			if config.Config.CosignerConfig.WitnessID != 0 {
+				return startWitness(cmd.Context())
+			}
+
 			var (
 				// services to stop on shutdown
 				services []tmService.Service
-				pv       types.PrivValidator
 				chainID  = config.Config.ChainID
 				logger   = tmlog.NewTMLogger(tmlog.NewSyncWriter(os.Stdout)).With("module", "validator")
 				cfg      signer.Config
@@ -129,6 +135,7 @@ func StartCosignerCmd() *cobra.Command {
 				PrivValStateDir:   config.StateDir,
 				ChainID:           config.Config.ChainID,
 				CosignerThreshold: config.Config.CosignerConfig.Threshold,
+				CosignerShares:    config.Config.CosignerConfig.Shares,
 				ListenAddress:     config.Config.CosignerConfig.P2PListen,
 				Nodes:             config.Config.Nodes(),
 				Cosigners:         config.Config.CosignerPeers(),
@@ -141,8 +148,13 @@ func StartCosignerCmd() *cobra.Command {
 			logger.Info("Tendermint Validator", "mode", cfg.Mode,
 				"priv-key", cfg.PrivValKeyFile, "priv-state-dir", cfg.PrivValStateDir)
 
+			startAlerting(logger)
+
 			var val types.PrivValidator
 
+			// service that must be ready to sign before connecting to the sentries
+			var readiness signer.Readiness
+
 			key, err := signer.LoadCosignerKey(cfg.PrivValKeyFile)
 			if err != nil {
 				return fmt.Errorf("error reading cosigner key: %s", err)
@@ -152,14 +164,18 @@ func StartCosignerCmd() *cobra.Command {
 			// protects against double sign - this exists as a cache for the final signature
 			signState, err := signer.LoadOrCreateSignState(config.privValStateFile(chainID))
 			if err != nil {
-				panic(err)
+				return err
 			}
 
 			// state for our cosigner share
 			// Not automatically initialized on disk to avoid double sign risk
 			shareSignState, err := signer.LoadSignState(config.shareStateFile(chainID))
 			if err != nil {
-				panic(err)
+				return err
+			}
+
+			if err := migrateLegacySignStates(logger, &signState, &shareSignState); err != nil {
+				return err
 			}
 
 			cosigners := []signer.Cosigner{}
@@ -185,7 +201,73 @@ func StartCosignerCmd() *cobra.Command {
 				})
 			}
 
-			total := len(cfg.Cosigners) + 1
+			// witnesses hold the replicated last sign state of the validator as well
+			var lastSignStateSources []*signer.RemoteCosigner
+			for _, cosignerConfig := range cfg.Cosigners {
+				lastSignStateSources = append(lastSignStateSources,
+					signer.NewRemoteCosigner(cosignerConfig.ID, cosignerConfig.Address))
+			}
+			for _, witness := range config.Config.CosignerWitnesses() {
+				lastSignStateSources = append(lastSignStateSources, signer.NewRemoteCosigner(witness.ID, witness.Address))
+			}
+
+			// catch up with the sign state of the cluster before taking part in signing,
+			// in case the sign state on disk was restored from an old backup.
+			// Peers that are starting as well may not answer yet, the catch-up is then retried
+			// before connecting to the sentries.
+			caughtUp := true
+			if err := signer.CatchUpSignState(logger, key.PubKey, lastSignStateSources,
+				cfg.CosignerThreshold-1, &signState, &shareSignState); err != nil {
+				logger.Info("Sign state not caught up with a quorum of peers yet, retrying before signing", "error", err)
+				caughtUp = false
+			}
+
+			localHeight := shareSignState.Height
+			if signState.Height < localHeight {
+				localHeight = signState.Height
+			}
+			if err := checkChainHistory(logger, key.PubKey.Address(), localHeight,
+				func(commit *signer.SignedCommit) error {
+					if err := signState.FastForward(commit); err != nil {
+						return err
+					}
+					return shareSignState.FastForward(commit)
+				}); err != nil {
+				return err
+			}
+
+			auditKey, err := signer.LoadOrCreateAuditKey(config.auditKeyFile())
+			if err != nil {
+				return fmt.Errorf("error loading audit key: %w", err)
+			}
+			auditLog, err := signer.OpenAuditLog(logger, config.auditLogFile(chainID), auditKey)
+			if err != nil {
+				return fmt.Errorf("error opening audit log, check it with horcrux audit verify "+
+					"and move it aside to start a new one: %w", err)
+			}
+			defer auditLog.Close()
+
+			// clock skew settings have been validated with the config
+			maxClockSkew, _ := config.Config.CosignerConfig.SignMaxClockSkew()
+			peerClockSkewLimit, _ := config.Config.CosignerConfig.PeerClockSkewLimit()
+
+			// paused and resumed by the operator through the admin API,
+			// a pause of the cluster stays in effect across restarts
+			pause := &signer.SigningPause{}
+			clusterPause, err := signer.LoadClusterPause(config.clusterPauseFile())
+			if err != nil {
+				return err
+			}
+			pause.SetClusterPause(clusterPause)
+			if clusterPause.Paused {
+				logger.Info("Signing is paused on all cosigners", "reason", clusterPause.Reason,
+					"resume_height", clusterPause.ResumeHeight)
+			}
+
+			upgrade := newChainUpgrade(logger)
+
+			// the number of shares of the key, the peers are fewer after cosigners were removed from the cluster
+			total := cfg.CosignerShares
 			localCosignerConfig := signer.LocalCosignerConfig{
 				CosignerKey: key,
 				SignState:   &shareSignState,
@@ -194,46 +276,131 @@ func StartCosignerCmd() *cobra.Command {
 				Peers:       peers,
 				Total:       uint8(total),
 				Threshold:   uint8(cfg.CosignerThreshold),
+				ChainID:     cfg.ChainID,
+
+				MaxClockSkew: maxClockSkew,
+				AuditLog:     auditLog,
+				Pause:        pause,
+				Upgrade:      upgrade,
 			}
 
 			localCosigner := signer.NewLocalCosigner(localCosignerConfig)
 
-			timeout, err := time.ParseDuration(config.Config.CosignerConfig.Timeout)
-			if err != nil {
-				log.Fatalf("Error parsing configured timeout: %s. %v\n", config.Config.CosignerConfig.Timeout, err)
-			}
+			// nil in leaderless mode
+			var raftStore *signer.RaftStore
+
+			if config.Config.CosignerConfig.Leaderless {
+				// No raft cluster, every cosigner coordinates the signing rounds for its own sentries
+				thresholdValidator := signer.NewThresholdValidator(&signer.ThresholdValidatorOpt{
+					Pubkey:     key.PubKey,
+					Threshold:  cfg.CosignerThreshold,
+					SignState:  signState,
+					Cosigner:   localCosigner,
+					Peers:      cosigners,
+					Leaderless: true,
+					Logger:     logger,
+
+					MaxClockSkew: maxClockSkew,
+					AuditLog:     auditLog,
+					Pause:        pause,
+					Upgrade:      upgrade,
+				})
+				grpcService := signer.NewGRPCService(cfg.ListenAddress, logger, localCosigner, thresholdValidator)
+				grpcService.Version = Version
+				if err := grpcService.Start(); err != nil {
+					log.Fatalf("Error starting cosigner gRPC service: %v\n", err)
+				}
+				services = append(services, grpcService)
+				val = thresholdValidator
+				readiness = grpcService
+			} else {
+				timeout, err := time.ParseDuration(config.Config.CosignerConfig.Timeout)
+				if err != nil {
+					log.Fatalf("Error parsing configured timeout: %s. %v\n", config.Config.CosignerConfig.Timeout, err)
+				}
 
-			raftDir := filepath.Join(config.HomeDir, "raft")
-			if err := os.MkdirAll(raftDir, 0700); err != nil {
-				log.Fatalf("Error creating raft directory: %v\n", err)
-			}
+				raftDir := filepath.Join(config.HomeDir, "raft")
+				if err := os.MkdirAll(raftDir, 0700); err != nil {
+					log.Fatalf("Error creating raft directory: %v\n", err)
+				}
 
-			// RAFT node ID is the cosigner ID
-			nodeID := fmt.Sprint(key.ID)
+				// RAFT node ID is the cosigner ID
+				nodeID := fmt.Sprint(key.ID)
+
+				// Start RAFT store listener
+				raftStore = signer.NewRaftStore(nodeID,
+					raftDir, cfg.ListenAddress, timeout, logger, localCosigner, cosigners)
+				raftStore.Version = Version
+				raftStore.Witnesses = config.Config.CosignerWitnesses()
+				raftStore.LeaderPlacement = config.Config.CosignerConfig.AutoLeaderPlacement
+				raftStore.LeaderPriorities = config.Config.CosignerLeaderPriorities(key.ID)
+				raftStore.AdminAuditFile = config.adminAuditFile()
+				raftStore.Pause = pause
+				raftStore.PauseFile = config.clusterPauseFile()
+				raftStore.Upgrade = upgrade
+				raftStore.OnPeersChanged = func(members []signer.CosignerConfig) {
+					if err := writeCosignerPeers(key.ID, members); err != nil {
+						logger.Error("Failed to write cosigner peers to config file", "error", err)
+					}
+				}
+				if err := raftStore.Start(); err != nil {
+					log.Fatalf("Error starting raft store: %v\n", err)
+				}
+				services = append(services, raftStore)
+
+				val = signer.NewThresholdValidator(&signer.ThresholdValidatorOpt{
+					Pubkey:    key.PubKey,
+					Threshold: cfg.CosignerThreshold,
+					SignState: signState,
+					Cosigner:  localCosigner,
+					Peers:     cosigners,
+					RaftStore: raftStore,
+					Logger:    logger,
+
+					MaxClockSkew: maxClockSkew,
+					AuditLog:     auditLog,
+					Pause:        pause,
+					Upgrade:      upgrade,
+				})
 
-			// Start RAFT store listener
-			raftStore := signer.NewRaftStore(nodeID,
-				raftDir, cfg.ListenAddress, timeout, logger, localCosigner, cosigners)
-			if err := raftStore.Start(); err != nil {
-				log.Fatalf("Error starting raft store: %v\n", err)
+				raftStore.SetThresholdValidator(val.(*signer.ThresholdValidator))
+				readiness = raftStore
 			}
-			services = append(services, raftStore)
 
-			val = signer.NewThresholdValidator(&signer.ThresholdValidatorOpt{
-				Pubkey:    key.PubKey,
-				Threshold: cfg.CosignerThreshold,
-				SignState: signState,
-				Cosigner:  localCosigner,
-				Peers:     cosigners,
-				RaftStore: raftStore,
-				Logger:    logger,
-			})
+			clockSkewMonitor := signer.NewClockSkewMonitor(logger, val.(*signer.ThresholdValidator), peerClockSkewLimit)
+			if err := clockSkewMonitor.Start(); err != nil {
+				log.Fatalf("Error starting clock skew monitor: %v\n", err)
+			}
+			services = append(services, clockSkewMonitor)
 
-			raftStore.SetThresholdValidator(val.(*signer.ThresholdValidator))
+			slashingRisk := signer.NewSlashingRiskDetector(logger, config.conflictingRequestsFile(chainID))
+			remoteSigners := signer.NewRemoteSigners(logger, cfg.ChainID, &signer.PvGuard{PrivValidator: val},
+				cfg.Nodes, slashingRisk)
+			remoteSigners.Upgrade = upgrade
 
-			pv = &signer.PvGuard{PrivValidator: val}
+			adminToken, err := signer.LoadOrCreateAdminToken(config.adminTokenFile())
+			if err != nil {
+				return fmt.Errorf("error loading admin token: %w", err)
+			}
+			adminService := signer.NewAdminService(logger, signer.AdminServiceConfig{
+				ListenAddress:      config.adminListenAddress(),
+				Token:              adminToken,
+				ChainID:            chainID,
+				Pause:              pause,
+				ThresholdValidator: val.(*signer.ThresholdValidator),
+				Cosigner:           localCosigner,
+				RaftStore:          raftStore,
+				RemoteSigners:      remoteSigners,
+				ReloadConfig: func() ([]string, error) {
+					return reloadCosignerConfig(logger, remoteSigners, upgrade)
+				},
+			})
+			if err := adminService.Start(); err != nil {
+				return fmt.Errorf("error starting admin service: %w", err)
+			}
+			services = append(services, adminService)
 
-			pubkey, err := pv.GetPubKey()
+			pubkey, err := val.GetPubKey()
 			if err != nil {
 				log.Fatal(err)
 			}
@@ -241,12 +408,30 @@ func StartCosignerCmd() *cobra.Command {
 
 			go EnableDebugAndMetrics(cmd.Context())
 
-			services, err = signer.StartRemoteSigners(services, logger, cfg.ChainID, pv, cfg.Nodes)
-			if err != nil {
+			if !caughtUp {
+				readiness = signer.NewSignStateCatchUpReadiness(logger, readiness, lastSignStateSources,
+					cfg.CosignerThreshold-1, val.(*signer.ThresholdValidator), localCosigner)
+			}
+
+			// readiness timeout has been validated with the config
+			readinessTimeout, _ := config.Config.CosignerConfig.SentryReadinessTimeout()
+			if err := signer.WaitUntilReady(logger, readiness, readinessTimeout); err != nil {
+				// the sign state may be behind the one of the cluster, signing could double sign
+				var catchUpErr *signer.SignStateCatchUpError
+				if errors.As(err, &catchUpErr) {
+					return fmt.Errorf("sign state could not be caught up with a quorum of peers: %w", err)
+				}
+				logger.Error("Connecting to sentries before the cosigner is ready", "error", err)
+			}
+
+			if err := remoteSigners.Start(); err != nil {
 				panic(err)
 			}
+			services = append(services, remoteSigners)
 
-			signer.WaitAndTerminate(logger, services, config.PidFile)
+			// drain timeout has been validated with the config
+			drainTimeout, _ := config.Config.ShutdownDrainTimeout()
+			signer.WaitAndTerminate(logger, services, config.PidFile, drainTimeout)
 
 			return nil
 		},
@@ -254,3 +439,123 @@ func StartCosignerCmd() *cobra.Command {
 
 	return cmd
 }
+
+// startWitness runs a raft witness node that holds no key share. It only votes in raft
+// elections and replicates the last sign state, it does not connect to any sentries.
+func startWitness(ctx context.Context) error {
+	logger := tmlog.NewTMLogger(tmlog.NewSyncWriter(os.Stdout)).With("module", "witness")
+	startAlerting(logger)
+	cosignerConfig := config.Config.CosignerConfig
+
+	timeout, err := time.ParseDuration(cosignerConfig.Timeout)
+	if err != nil {
+		return fmt.Errorf("error parsing configured timeout: %s. %w", cosignerConfig.Timeout, err)
+	}
+
+	raftDir := filepath.Join(config.HomeDir, "raft")
+	if err := os.MkdirAll(raftDir, 0700); err != nil {
+		return fmt.Errorf("error creating raft directory: %w", err)
+	}
+
+	signState, err := signer.LoadOrCreateSignState(config.privValStateFile(config.Config.ChainID))
+	if err != nil {
+		return err
+	}
+	if err := migrateLegacySignStates(logger, &signState); err != nil {
+		return err
+	}
+
+	cosigners := []signer.Cosigner{}
+	for _, cosignerConfig := range config.Config.CosignerPeers() {
+		cosigners = append(cosigners, signer.NewRemoteCosigner(cosignerConfig.ID, cosignerConfig.Address))
+	}
+
+	witnessID := cosignerConfig.WitnessID
+	raftStore := signer.NewWitnessRaftStore(fmt.Sprint(witnessID),
+		raftDir, cosignerConfig.P2PListen, timeout, logger, &signState, cosigners)
+	raftStore.Version = Version
+	raftStore.Witnesses = config.Config.CosignerWitnesses()
+	raftStore.LeaderPriorities = config.Config.CosignerLeaderPriorities(witnessID)
+	raftStore.AdminAuditFile = config.adminAuditFile()
+	raftStore.Upgrade = newChainUpgrade(logger)
+	raftStore.OnPeersChanged = func(members []signer.CosignerConfig) {
+		if err := writeCosignerPeers(witnessID, members); err != nil {
+			logger.Error("Failed to write cosigner peers to config file", "error", err)
+		}
+	}
+	if err := raftStore.Start(); err != nil {
+		return fmt.Errorf("error starting raft store: %w", err)
+	}
+
+	logger.Info("Witness", "id", witnessID, "address", cosignerConfig.P2PListen)
+
+	go EnableDebugAndMetrics(ctx)
+
+	// drain timeout has been validated with the config
+	drainTimeout, _ := config.Config.ShutdownDrainTimeout()
+	signer.WaitAndTerminate(logger, []tmService.Service{raftStore}, config.PidFile, drainTimeout)
+	return nil
+}
+
+// newChainUpgrade returns the chain upgrade planned in the config file,
+// which is updated with the new chain ID once the upgrade has been applied.
+func newChainUpgrade(logger tmlog.Logger) *signer.ChainUpgrade {
+	upgrade := signer.NewChainUpgrade(config.Config.ChainID, config.Config.UpgradePlan())
+	upgrade.PrivValStateFile = config.privValStateFile
+	upgrade.ShareStateFile = config.shareStateFile
+	upgrade.OnUpgraded = func(plan signer.UpgradePlan) {
+		if err := writeUpgradedChainID(plan); err != nil {
+			logger.Error("Failed to write new chain ID to config file", "error", err)
+		}
+	}
+	return upgrade
+}
+
+// writeUpgradedChainID persists the chain ID of an applied upgrade to the config file and removes the plan
+func writeUpgradedChainID(plan signer.UpgradePlan) error {
+	config.Config.ChainID = plan.NewChainID
+	config.Config.Upgrade = nil
+	return config.writeConfigFile()
+}
+
+// writeCosignerPeers persists the cosigners of a runtime membership change to the config file.
+// The cosigner with our share ID is not written, as we are not our own peer.
+// migrateLegacySignStates rewrites sign states written by earlier versions of horcrux with a version and checksum.
+// Only done when starting to sign, the state commands leave the sign states as they are.
+func migrateLegacySignStates(logger tmlog.Logger, signStates ...*signer.SignState) error {
+	for _, signState := range signStates {
+		migrated, err := signState.MigrateLegacy()
+		if err != nil {
+			return err
+		}
+		if migrated {
+			logger.Info("Rewrote sign state with a version and checksum", "height", signState.Height,
+				"round", signState.Round, "step", signState.Step)
+		}
+	}
+	return nil
+}
+
+func writeCosignerPeers(ourID int, members []signer.CosignerConfig) error {
+	// leader priorities are configured per node, keep the ones of existing peers
+	priorities := make(map[int]int)
+	for _, peer := range config.Config.CosignerConfig.Peers {
+		priorities[peer.ShareID] = peer.LeaderPriority
+	}
+
+	peers := make([]CosignerPeer, 0, len(members))
+	for _, member := range members {
+		if member.ID == ourID {
+			continue
+		}
+		peers = append(peers, CosignerPeer{
+			ShareID:        member.ID,
+			P2PAddr:        member.Address,
+			LeaderPriority: priorities[member.ID],
+		})
+	}
+	sort.Slice(peers, func(i, j int) bool { return peers[i].ShareID < peers[j].ShareID })
+
+	config.Config.CosignerConfig.Peers = peers
+	return config.writeConfigFile()
+}
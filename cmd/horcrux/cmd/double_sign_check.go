@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/strangelove-ventures/horcrux/signer"
+	"github.com/tendermint/tendermint/crypto"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+)
+
+// checkChainHistory looks for commits that the validator signed above the local sign state height in
+// the most recent blocks of the chain, if configured. Depending on the configured action, it returns an
+// error to refuse to start, or calls fastForward with the highest signed commit.
+func checkChainHistory(
+	logger tmlog.Logger,
+	address crypto.Address,
+	localHeight int64,
+	fastForward func(commit *signer.SignedCommit) error,
+) error {
+	cfg := config.Config.DoubleSignCheck
+	if cfg == nil {
+		return nil
+	}
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), time.Minute)
+	defer cancelFunc()
+
+	check := signer.DoubleSignCheck{RPCAddress: cfg.RPCAddr, Heights: cfg.Heights}
+	commit, err := check.HighestSignedCommit(ctx, address, localHeight)
+	if err != nil {
+		return fmt.Errorf("double sign check failed: %w", err)
+	}
+	if commit == nil {
+		logger.Info("Double sign check found no signed commits above the local sign state",
+			"height", localHeight, "checked_heights", cfg.Heights)
+		return nil
+	}
+
+	if cfg.Action != doubleSignCheckFastForward {
+		return fmt.Errorf("validator signed the commit at height %d round %d, above the local sign state height %d. "+
+			"refusing to start to avoid double signing, restore the sign state or set the double sign check action to %s",
+			commit.Height, commit.Round, localHeight, doubleSignCheckFastForward)
+	}
+
+	logger.Info("Fast-forwarding sign state past the highest signed commit",
+		"height", commit.Height, "round", commit.Round, "local_height", localHeight)
+	return fastForward(commit)
+}
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"io"
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -18,6 +19,12 @@ func TestKey2Shares(t *testing.T) {
 	pv := privval.NewFilePV(ed25519.GenPrivKey(), privValidatorKeyFile, privValidatorStateFile)
 	pv.Save()
 
+	// the shares are written to the working directory
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(tmp))
+	t.Cleanup(func() { _ = os.Chdir(wd) })
+
 	tcs := []struct {
 		name      string
 		args      []string
@@ -3,7 +3,6 @@ package cmd
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	_ "github.com/Jille/grpc-multi-resolver"
@@ -31,45 +30,40 @@ To choose a specific leader, pass that leader's ID as an argument.
 horcrux elect 2 # elect specific leader`,
 	SilenceUsage: true,
 	RunE: func(cmd *cobra.Command, args []string) (err error) {
-		if config.Config.CosignerConfig == nil {
-			return fmt.Errorf("cosigner configuration is not present in config file")
+		if err := requireRaftCosignerConfig(); err != nil {
+			return err
 		}
 
-		if len(config.Config.CosignerConfig.Peers) == 0 {
-			return fmt.Errorf("cosigner configuration has no peers")
-		}
+		leaderID := ""
 
-		serviceConfig := `{"healthCheckConfig": {"serviceName": "Leader"}, "loadBalancingConfig": [ { "round_robin": {} } ]}`
-		retryOpts := []grpc_retry.CallOption{
-			grpc_retry.WithBackoff(grpc_retry.BackoffExponential(100 * time.Millisecond)),
-			grpc_retry.WithMax(5),
+		if len(args) > 0 {
+			leaderID = args[0]
 		}
 
-		grpcAddress, err := config.Config.CosignerConfig.LeaderElectMultiAddress()
+		// through the admin API if the cosigner is running on this node
+		adminClient, adminConn, err := runningAdminGRPCClient()
 		if err != nil {
 			return err
 		}
+		if adminClient != nil {
+			defer adminConn.Close()
+			return electWithAdmin(cmd, args, adminClient, leaderID)
+		}
 
-		fmt.Printf("Broadcasting to address: %s\n", grpcAddress)
-		conn, err := grpc.Dial(grpcAddress,
-			grpc.WithDefaultServiceConfig(serviceConfig), grpc.WithTransportCredentials(insecure.NewCredentials()),
-			grpc.WithDefaultCallOptions(grpc.WaitForReady(true)),
-			grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(retryOpts...)))
+		grpcClient, conn, err := leaderGRPCClient()
 		if err != nil {
-			log.Fatalf("dialing failed: %v", err)
+			return err
 		}
 		defer conn.Close()
 
-		leaderID := ""
-
-		if len(args) > 0 {
-			leaderID = args[0]
-		}
-
 		ctx, cancelFunc := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancelFunc()
 
-		grpcClient := proto.NewCosignerGRPCClient(conn)
+		action := newAdminAction(cmd, args)
+		if before, err := grpcClient.GetLeader(ctx, &proto.CosignerGRPCGetLeaderRequest{}); err == nil {
+			_ = action.SetBefore(before.Leader)
+		}
+
 		_, err = grpcClient.TransferLeadership(
 			ctx,
 			&proto.CosignerGRPCTransferLeadershipRequest{LeaderID: leaderID},
@@ -85,10 +79,75 @@ horcrux elect 2 # elect specific leader`,
 
 		fmt.Printf("Leader election successful. New leader: %s\n", res.Leader)
 
+		_ = action.SetAfter(res.Leader)
+		recordClusterAdminAction(cmd, replicateWithCosigner(grpcClient), action)
+
 		return nil
 	},
 }
 
+// electWithAdmin transfers the raft leadership through the admin API of the cosigner on this node
+func electWithAdmin(cmd *cobra.Command, args []string, grpcClient proto.AdminGRPCClient, leaderID string) error {
+	ctx, cancelFunc := adminContext()
+	defer cancelFunc()
+
+	action := newAdminAction(cmd, args)
+	if before, err := grpcClient.ListPeers(ctx, &proto.AdminGRPCListPeersRequest{}); err == nil {
+		_ = action.SetBefore(before.Leader)
+	}
+
+	res, err := grpcClient.TransferLeadership(ctx, &proto.AdminGRPCTransferLeadershipRequest{LeaderID: leaderID})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Leader election successful. New leader: %s\n", res.Leader)
+
+	_ = action.SetAfter(res.Leader)
+	recordClusterAdminAction(cmd, replicateWithAdmin(grpcClient), action)
+	return nil
+}
+
+// requireRaftCosignerConfig checks that the config describes a cosigner that is part of a raft cluster.
+func requireRaftCosignerConfig() error {
+	if config.Config.CosignerConfig == nil {
+		return fmt.Errorf("cosigner configuration is not present in config file")
+	}
+
+	if len(config.Config.CosignerConfig.Peers) == 0 {
+		return fmt.Errorf("cosigner configuration has no peers")
+	}
+
+	if config.Config.CosignerConfig.Leaderless {
+		return fmt.Errorf("cosigner is configured in leaderless mode, there is no raft leader")
+	}
+	return nil
+}
+
+// leaderGRPCClient dials all configured cosigners and sends requests to the one that is the raft leader.
+func leaderGRPCClient() (proto.CosignerGRPCClient, *grpc.ClientConn, error) {
+	serviceConfig := `{"healthCheckConfig": {"serviceName": "Leader"}, "loadBalancingConfig": [ { "round_robin": {} } ]}`
+	retryOpts := []grpc_retry.CallOption{
+		grpc_retry.WithBackoff(grpc_retry.BackoffExponential(100 * time.Millisecond)),
+		grpc_retry.WithMax(5),
+	}
+
+	grpcAddress, err := config.Config.CosignerConfig.LeaderElectMultiAddress()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fmt.Printf("Broadcasting to address: %s\n", grpcAddress)
+	conn, err := grpc.Dial(grpcAddress,
+		grpc.WithDefaultServiceConfig(serviceConfig), grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.WaitForReady(true)),
+		grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(retryOpts...)))
+	if err != nil {
+		return nil, nil, fmt.Errorf("dialing failed: %w", err)
+	}
+	return proto.NewCosignerGRPCClient(conn), conn, nil
+}
+
 var getLeaderCmd = &cobra.Command{
 	Use:          "leader",
 	Short:        "Get current raft leader",
@@ -96,12 +155,8 @@ var getLeaderCmd = &cobra.Command{
 	Example:      `horcrux leader`,
 	SilenceUsage: true,
 	RunE: func(cmd *cobra.Command, args []string) (err error) {
-		if config.Config.CosignerConfig == nil {
-			return fmt.Errorf("cosigner configuration is not present in config file")
-		}
-
-		if len(config.Config.CosignerConfig.Peers) == 0 {
-			return fmt.Errorf("cosigner configuration has no peers")
+		if err := requireRaftCosignerConfig(); err != nil {
+			return err
 		}
 
 		retryOpts := []grpc_retry.CallOption{
@@ -120,7 +175,7 @@ var getLeaderCmd = &cobra.Command{
 			grpc.WithDefaultCallOptions(grpc.WaitForReady(true)),
 			grpc.WithUnaryInterceptor(grpc_retry.UnaryClientInterceptor(retryOpts...)))
 		if err != nil {
-			log.Fatalf("dialing failed: %v", err)
+			return fmt.Errorf("dialing failed: %w", err)
 		}
 		defer conn.Close()
 
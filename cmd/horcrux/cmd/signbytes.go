@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/strangelove-ventures/horcrux/signer"
+	tmProto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func init() {
+	rootCmd.AddCommand(decodeSignBytesCmd())
+}
+
+func decodeSignBytesCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "decode-signbytes [hex]",
+		Short: "Decode the sign bytes of a proposal or vote",
+		Long: "Decode the sign bytes of a proposal or vote, i.e. from a sign state file or the conflicting requests,\n" +
+			"and show the type, height, round, block ID, part set header, POL round, timestamp and chain ID.",
+		Example: `horcrux decode-signbytes 6B080211BA8305000000000022480A20...`,
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			signBytes, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(args[0]), "0x"))
+			if err != nil {
+				return fmt.Errorf("sign bytes must be hex encoded: %w", err)
+			}
+
+			// silence usage after all input has been validated
+			cmd.SilenceUsage = true
+
+			content, err := signer.DecodeSignBytes(signBytes)
+			if err != nil {
+				return err
+			}
+			printSignBytesContent(cmd.OutOrStdout(), "", content)
+			return nil
+		},
+	}
+}
+
+// printSignBytesContent prints the decoded proposal or vote, one field per line
+func printSignBytesContent(w io.Writer, indent string, content signer.SignBytesContent) {
+	fmt.Fprintf(w, "%sType:            %s\n", indent, content.TypeName())
+	fmt.Fprintf(w, "%sChain ID:        %s\n", indent, content.ChainID)
+	fmt.Fprintf(w, "%sHeight:          %d\n", indent, content.HRST.Height)
+	fmt.Fprintf(w, "%sRound:           %d\n", indent, content.HRST.Round)
+	fmt.Fprintf(w, "%sBlock ID:        %s\n", indent, content.BlockIDHash())
+	fmt.Fprintf(w, "%sPart Set Header: %s\n", indent, content.PartSetHeader())
+	if content.Type == tmProto.ProposalType {
+		fmt.Fprintf(w, "%sPOL Round:       %d\n", indent, content.POLRound)
+	}
+	fmt.Fprintf(w, "%sTimestamp:       %s\n", indent, content.Timestamp().Format(time.RFC3339Nano))
+}
+
+// printSignBytes prints the sign bytes of a sign state in hex and decoded
+func printSignBytes(w io.Writer, signBytes []byte) {
+	fmt.Fprintf(w, "  SignBytes: %X\n", signBytes)
+	content, err := signer.DecodeSignBytes(signBytes)
+	if err != nil {
+		fmt.Fprintf(w, "    %v\n", err)
+		return
+	}
+	printSignBytesContent(w, "    ", content)
+}
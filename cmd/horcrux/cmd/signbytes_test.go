@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tm "github.com/tendermint/tendermint/types"
+)
+
+func TestDecodeSignBytesCmd(t *testing.T) {
+	proposal := tmproto.Proposal{
+		Type:      tmproto.ProposalType,
+		Height:    1500000,
+		Round:     1,
+		PolRound:  -1,
+		Timestamp: time.Date(2022, 4, 15, 5, 20, 0, 0, time.UTC),
+		BlockID: tmproto.BlockID{
+			Hash:          bytes.Repeat([]byte{0xAB}, 32),
+			PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: bytes.Repeat([]byte{0xEF}, 32)},
+		},
+	}
+	signBytes := tm.ProposalSignBytes("horcrux-1", &proposal)
+
+	var out bytes.Buffer
+	cmd := decodeSignBytesCmd()
+	cmd.SetOutput(&out)
+	cmd.SetArgs([]string{hex.EncodeToString(signBytes)})
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(), "Type:            Proposal\n")
+	require.Contains(t, out.String(), "Chain ID:        horcrux-1\n")
+	require.Contains(t, out.String(), "Height:          1500000\n")
+	require.Contains(t, out.String(), "POL Round:       -1\n")
+	require.Contains(t, out.String(), "Timestamp:       2022-04-15T05:20:00Z\n")
+
+	cmd = decodeSignBytesCmd()
+	cmd.SetOutput(&out)
+	cmd.SetArgs([]string{"not hex"})
+	require.Error(t, cmd.Execute())
+
+	cmd = decodeSignBytesCmd()
+	cmd.SetOutput(&out)
+	cmd.SetArgs([]string{"0102"})
+	require.Error(t, cmd.Execute())
+}
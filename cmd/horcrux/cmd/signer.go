@@ -62,10 +62,19 @@ func StartSignerCmd() *cobra.Command {
 			logger.Info("Tendermint Validator", "mode", cfg.Mode,
 				"priv-key", cfg.PrivValKeyFile, "priv-state-dir", cfg.PrivValStateDir)
 
-			pv = &signer.PvGuard{
-				PrivValidator: privval.LoadFilePVEmptyState(cfg.PrivValKeyFile, config.privValStateFile(chainID)),
+			startAlerting(logger)
+
+			filePV := privval.LoadFilePVEmptyState(cfg.PrivValKeyFile, config.privValStateFile(chainID))
+			if err := checkChainHistory(logger, filePV.Key.Address, filePV.LastSignState.Height,
+				func(commit *signer.SignedCommit) error {
+					signer.FastForwardFilePV(filePV, commit)
+					return nil
+				}); err != nil {
+				return err
 			}
 
+			pv = &signer.PvGuard{PrivValidator: filePV}
+
 			pubkey, err := pv.GetPubKey()
 			if err != nil {
 				log.Fatal(err)
@@ -74,12 +83,15 @@ func StartSignerCmd() *cobra.Command {
 
 			go EnableDebugAndMetrics(cmd.Context())
 
-			services, err = signer.StartRemoteSigners(services, logger, cfg.ChainID, pv, cfg.Nodes)
+			// the file priv validator does not return the conflicting sign bytes, so conflicts are only logged
+			services, err = signer.StartRemoteSigners(services, logger, cfg.ChainID, pv, cfg.Nodes, nil)
 			if err != nil {
 				panic(err)
 			}
 
-			signer.WaitAndTerminate(logger, services, config.PidFile)
+			// drain timeout has been validated with the config
+			drainTimeout, _ := config.Config.ShutdownDrainTimeout()
+			signer.WaitAndTerminate(logger, services, config.PidFile, drainTimeout)
 
 			return nil
 		},
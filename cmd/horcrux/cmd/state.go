@@ -2,15 +2,20 @@ package cmd
 
 import (
 	"bufio"
+	"context"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/strangelove-ventures/horcrux/signer"
+	"github.com/strangelove-ventures/horcrux/signer/proto"
 
 	tmjson "github.com/tendermint/tendermint/libs/json"
 )
@@ -27,6 +32,8 @@ func init() {
 	stateCmd.AddCommand(showStateCmd())
 	stateCmd.AddCommand(setStateCmd())
 	stateCmd.AddCommand(importStateCmd())
+	stateCmd.AddCommand(conflictsCmd())
+	stateCmd.AddCommand(migrateStateCmd())
 
 	rootCmd.AddCommand(stateCmd)
 }
@@ -37,10 +44,12 @@ var stateCmd = &cobra.Command{
 }
 
 func showStateCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:          "show",
-		Aliases:      []string{"s"},
-		Short:        "Show the priv validator and share sign state",
+	cmd := &cobra.Command{
+		Use:     "show",
+		Aliases: []string{"s"},
+		Short:   "Show the priv validator and share sign state",
+		Long: "Show the priv validator and share sign state.\n" +
+			"With --cluster, every cosigner is asked for its sign states, and the cosigners that are behind are shown.",
 		Args:         cobra.ExactArgs(0),
 		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -48,6 +57,24 @@ func showStateCmd() *cobra.Command {
 				return fmt.Errorf("%s does not exist, initialize config with horcrux config init and try again", config.HomeDir)
 			}
 
+			if cluster, _ := cmd.Flags().GetBool("cluster"); cluster {
+				if config.Config.CosignerConfig == nil {
+					return fmt.Errorf("cosigner configuration is not present in config file")
+				}
+				printClusterSignStates(cmd, getClusterStatus())
+				return nil
+			}
+
+			// the sign state of a running cosigner is ahead of the state files, which are saved asynchronously
+			grpcClient, conn, err := runningAdminGRPCClient()
+			if err != nil {
+				return err
+			}
+			if grpcClient != nil {
+				defer conn.Close()
+				return showRunningState(cmd, grpcClient)
+			}
+
 			pv, err := signer.LoadSignState(config.privValStateFile(config.Config.ChainID))
 			if err != nil {
 				return err
@@ -65,13 +92,261 @@ func showStateCmd() *cobra.Command {
 			return nil
 		},
 	}
+	cmd.Flags().Bool("cluster", false, "show the sign states of every cosigner in the cluster")
+	return cmd
+}
+
+// printClusterSignStates prints the sign states of every cosigner, and where they diverge
+func printClusterSignStates(cmd *cobra.Command, nodes []ClusterStatusNode) {
+	w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tADDRESS\tPRIVVAL HRS\tSHARE HRS")
+	for _, node := range nodes {
+		if node.Error != "" {
+			fmt.Fprintf(w, "-\t%s\terror: %s\n", node.Address, node.Error)
+			continue
+		}
+		id := fmt.Sprint(node.ShareID)
+		if node.Witness {
+			id += " (witness)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", id, node.Address, node.LastSignState, node.ShareSignState)
+	}
+	w.Flush()
+
+	divergence := signStateDivergence(nodes)
+	if len(divergence) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), "\nSign states of all cosigners match")
+		return
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), "\nSign states diverge:")
+	for _, line := range divergence {
+		fmt.Fprintf(cmd.OutOrStdout(), "  %s\n", line)
+	}
+}
+
+// signStateDivergence describes the cosigners whose privval or share sign state is behind the most recent one,
+// and the cosigners that could not be asked
+func signStateDivergence(nodes []ClusterStatusNode) []string {
+	var latestPrivVal, latestShare *ClusterStatusHRS
+	for _, node := range nodes {
+		if node.Error != "" {
+			continue
+		}
+		if node.LastSignState != nil && (latestPrivVal == nil || latestPrivVal.less(*node.LastSignState)) {
+			latestPrivVal = node.LastSignState
+		}
+		if node.ShareSignState != nil && (latestShare == nil || latestShare.less(*node.ShareSignState)) {
+			latestShare = node.ShareSignState
+		}
+	}
+
+	var divergence []string
+	for _, node := range nodes {
+		if node.Error != "" {
+			divergence = append(divergence, fmt.Sprintf("cosigner at %s is unreachable", node.Address))
+			continue
+		}
+		if node.LastSignState != nil && *node.LastSignState != *latestPrivVal {
+			divergence = append(divergence, fmt.Sprintf("cosigner %d privval sign state %s is behind %s",
+				node.ShareID, node.LastSignState, latestPrivVal))
+		}
+		if node.ShareSignState != nil && *node.ShareSignState != *latestShare {
+			divergence = append(divergence, fmt.Sprintf("cosigner %d share sign state %s is behind %s",
+				node.ShareID, node.ShareSignState, latestShare))
+		}
+	}
+	return divergence
+}
+
+// showRunningState prints the sign state of the running cosigner
+func showRunningState(cmd *cobra.Command, grpcClient proto.AdminGRPCClient) error {
+	ctx, cancelFunc := adminContext()
+	defer cancelFunc()
+	res, err := grpcClient.GetState(ctx, &proto.AdminGRPCGetStateRequest{})
+	if err != nil {
+		return err
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), "Private Validator State:")
+	printAdminSignState(cmd, res.PrivValState)
+	fmt.Fprintln(cmd.OutOrStdout(), "Share Sign State:")
+	printAdminSignState(cmd, res.ShareState)
+	if res.Paused {
+		fmt.Fprintf(cmd.OutOrStdout(), "Signing paused since %s: %s\n",
+			time.Unix(0, res.PausedSince).UTC().Format(time.RFC3339), orDefault(res.PauseReason, "no reason given"))
+	}
+	if res.ClusterPaused {
+		fmt.Fprintf(cmd.OutOrStdout(), "Signing paused on all cosigners since %s: %s\n",
+			time.Unix(0, res.ClusterPausedSince).UTC().Format(time.RFC3339),
+			orDefault(res.ClusterPauseReason, "no reason given"))
+		if res.ResumeHeight != 0 {
+			fmt.Fprintf(cmd.OutOrStdout(), "Resuming at height %d\n", res.ResumeHeight)
+		}
+	}
+	if res.NewChainID != "" {
+		fmt.Fprintf(cmd.OutOrStdout(), "Upgrading %s after height %d to %s starting at height %d\n",
+			res.ChainID, res.HaltHeight, res.NewChainID, res.StartHeight)
+	}
+	return nil
+}
+
+func printAdminSignState(cmd *cobra.Command, ss *proto.AdminSignState) {
+	fmt.Fprintf(cmd.OutOrStdout(), "  Height:    %v\n"+
+		"  Round:     %v\n"+
+		"  Step:      %v\n",
+		ss.GetHeight(), ss.GetRound(), ss.GetStep())
+	if ss.GetSignBytes() != nil {
+		printSignBytes(cmd.OutOrStdout(), ss.GetSignBytes())
+	}
+}
+
+func conflictsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "conflicts",
+		Short: "Show the sign requests that were refused because they conflict with an already signed block",
+		Long: "Show the sign requests that were refused because signing them would have been a double sign,\n" +
+			"with both sign bytes decoded and the sentries that sent them",
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			requests, err := signer.ReadConflictingRequests(config.conflictingRequestsFile(config.Config.ChainID))
+			if err != nil {
+				return err
+			}
+
+			minHeight, _ := cmd.Flags().GetInt64("min-height")
+			filtered := make([]signer.ConflictingRequest, 0, len(requests))
+			for _, req := range requests {
+				if req.Height >= minHeight {
+					filtered = append(filtered, req)
+				}
+			}
+
+			if asJSON, _ := cmd.Flags().GetBool("json"); asJSON {
+				out, err := json.MarshalIndent(filtered, "", "  ")
+				if err != nil {
+					return err
+				}
+				fmt.Fprintln(cmd.OutOrStdout(), string(out))
+				return nil
+			}
+
+			if len(filtered) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "No conflicting sign requests")
+				return nil
+			}
+			w := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+			fmt.Fprintln(w, "TIME\tHRS\tTYPE\tSENTRY\tBLOCK ID\tSIGNED BY SENTRY\tSIGNED BLOCK ID\tREASON")
+			for _, req := range filtered {
+				fmt.Fprintf(w, "%s\t%d/%d/%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+					req.Time.Format(time.RFC3339), req.Height, req.Round, req.Step, req.New.Type,
+					orDefault(req.New.Sentry, "-"), orDefault(req.New.BlockID, "nil"),
+					orDefault(req.Existing.Sentry, "-"), orDefault(req.Existing.BlockID, "nil"),
+					req.Reason)
+			}
+			return w.Flush()
+		},
+	}
+	cmd.Flags().Int64("min-height", 0, "only show conflicting sign requests at or above this height")
+	cmd.Flags().Bool("json", false, "print the conflicting sign requests as JSON, with the full sign bytes")
+	return cmd
+}
+
+// orDefault returns the default for an empty string
+func orDefault(s, def string) string {
+	if s == "" {
+		return def
+	}
+	return s
+}
+
+func migrateStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Convert the sign states to another sign state backend",
+		Long: "Convert the priv validator and share sign states of all chains in the state directory\n" +
+			"to the json or boltdb sign state backend and configure the backend. horcrux must not be running.",
+		Example:      `horcrux state migrate --to boltdb`,
+		Args:         cobra.ExactArgs(0),
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			to, _ := cmd.Flags().GetString("to")
+			if to != signStateBackendJSON && to != signStateBackendBoltDB {
+				return fmt.Errorf("--to must be %s or %s", signStateBackendJSON, signStateBackendBoltDB)
+			}
+			from := config.Config.SignStateBackend
+			if from == "" {
+				from = signStateBackendJSON
+			}
+			if from == to {
+				return fmt.Errorf("sign states are already stored with the %s backend", to)
+			}
+			if to == signStateBackendBoltDB && config.Config.CosignerConfig == nil {
+				return fmt.Errorf("the %s sign state backend is only supported for cosigners", signStateBackendBoltDB)
+			}
+
+			// the sign states must not change while they are converted
+			if err := signer.RequireNotRunning(config.PidFile); err != nil {
+				return err
+			}
+
+			files, err := signStateFiles(signStateFileExt(from))
+			if err != nil {
+				return err
+			}
+			for _, file := range files {
+				dst := strings.TrimSuffix(file, signStateFileExt(from)) + signStateFileExt(to)
+				if err := signer.ConvertSignState(file, dst); err != nil {
+					return fmt.Errorf("error converting %s: %w", file, err)
+				}
+				fmt.Fprintf(cmd.OutOrStdout(), "Converted %s to %s\n", file, dst)
+			}
+
+			config.Config.SignStateBackend = to
+			if err := config.writeConfigFile(); err != nil {
+				return err
+			}
+
+			// the converted sign states are not kept, they would fall behind and allow double signs
+			for _, file := range files {
+				if err := os.Remove(file); err != nil {
+					return err
+				}
+			}
+
+			action := newAdminAction(cmd, args)
+			_ = action.SetBefore(from)
+			_ = action.SetAfter(to)
+			recordAdminAction(cmd, action)
+			return nil
+		},
+	}
+	cmd.Flags().String("to", "", "sign state backend to convert to: json or boltdb")
+	_ = cmd.MarkFlagRequired("to")
+	return cmd
+}
+
+// signStateFiles returns the priv validator and share sign state files of all chains in the state directory
+func signStateFiles(ext string) ([]string, error) {
+	var files []string
+	for _, pattern := range []string{"*_priv_validator_state" + ext, "*_share_sign_state" + ext} {
+		matches, err := filepath.Glob(filepath.Join(config.StateDir, pattern))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, matches...)
+	}
+	return files, nil
 }
 
 func setStateCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:          "set [height]",
-		Aliases:      []string{"s"},
-		Short:        "Set the height for both the priv validator and the share sign state",
+	cmd := &cobra.Command{
+		Use:     "set [height]",
+		Aliases: []string{"s"},
+		Short:   "Set the height for both the priv validator and the share sign state",
+		Long: "Set the height for both the priv validator and the share sign state.\n" +
+			"If the cosigner is running, signing must be paused first with horcrux admin pause.\n" +
+			"With --cluster, the height is set on all cosigners at the same raft log entry,\n" +
+			"signing must be paused first with horcrux admin pause --cluster.",
 		Args:         cobra.ExactArgs(1),
 		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -80,38 +355,48 @@ func setStateCmd() *cobra.Command {
 				return fmt.Errorf("%s does not exist, initialize config with horcrux config init and try again", config.HomeDir)
 			}
 
-			// Resetting the priv_validator_state.json should only be allowed if the
-			// signer is not running.
-			if err := signer.RequireNotRunning(config.PidFile); err != nil {
+			height, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				cmd.SilenceUsage = false
 				return err
 			}
+			signState := signer.NewSignStateConsensus(height, 0, 0)
 
-			pv, err := signer.LoadSignState(config.privValStateFile(config.Config.ChainID))
+			if cluster, _ := cmd.Flags().GetBool("cluster"); cluster {
+				grpcClient, conn, err := adminGRPCClient()
+				if err != nil {
+					return err
+				}
+				defer conn.Close()
+				return setRunningState(cmd, args, grpcClient, signState, true)
+			}
+
+			// the sign state of a running cosigner is only set through its admin API, while paused
+			grpcClient, conn, err := runningAdminGRPCClient()
 			if err != nil {
 				return err
 			}
+			if grpcClient != nil {
+				defer conn.Close()
+				return setRunningState(cmd, args, grpcClient, signState, false)
+			}
 
-			share, err := signer.LoadSignState(config.shareStateFile(config.Config.ChainID))
+			pv, err := signer.LoadSignState(config.privValStateFile(config.Config.ChainID))
 			if err != nil {
 				return err
 			}
 
-			height, err := strconv.ParseInt(args[0], 10, 64)
+			share, err := signer.LoadSignState(config.shareStateFile(config.Config.ChainID))
 			if err != nil {
-				cmd.SilenceUsage = false
 				return err
 			}
 
 			fmt.Fprintf(cmd.OutOrStdout(), "Setting height %d\n", height)
 
+			action := newAdminAction(cmd, args)
+			_ = action.SetBefore(newSignStatesHRS(pv, share))
+
 			pv.EphemeralPublic, share.EphemeralPublic = nil, nil
-			signState := signer.SignStateConsensus{
-				Height:    height,
-				Round:     0,
-				Step:      0,
-				Signature: nil,
-				SignBytes: nil,
-			}
 			err = pv.Save(signState, nil, false)
 			if err != nil {
 				fmt.Printf("error saving privval sign state")
@@ -122,17 +407,76 @@ func setStateCmd() *cobra.Command {
 				fmt.Printf("error saving share sign state")
 				return err
 			}
+
+			_ = action.SetAfter(newSignStatesHRS(pv, share))
+			recordAdminAction(cmd, action)
 			return nil
 		},
 	}
+	cmd.Flags().Bool("cluster", false, "set the height on all cosigners through raft")
+	return cmd
+}
+
+// setRunningState sets the sign state of the running cosigner, which must be paused,
+// or of all cosigners through raft while the cluster is paused
+func setRunningState(cmd *cobra.Command, args []string, grpcClient proto.AdminGRPCClient,
+	signState signer.SignStateConsensus, cluster bool) error {
+	ctx, cancelFunc := adminContext()
+	defer cancelFunc()
+
+	before, err := grpcClient.GetState(ctx, &proto.AdminGRPCGetStateRequest{})
+	if err != nil {
+		return err
+	}
+	switch {
+	case cluster && !before.ClusterPaused:
+		return fmt.Errorf("pause signing on all cosigners with horcrux admin pause --cluster " +
+			"to set the sign state of the cluster")
+	case !cluster && !before.Paused:
+		return fmt.Errorf("horcrux is running, pause signing with horcrux admin pause to set the sign state")
+	}
+
+	if cluster {
+		fmt.Fprintf(cmd.OutOrStdout(), "Setting height %d on all cosigners\n", signState.Height)
+	} else {
+		fmt.Fprintf(cmd.OutOrStdout(), "Setting height %d\n", signState.Height)
+	}
+	res, err := grpcClient.SetState(ctx, &proto.AdminGRPCSetStateRequest{
+		Height:  signState.Height,
+		Round:   signState.Round,
+		Step:    int32(signState.Step),
+		Cluster: cluster,
+	})
+	if err != nil {
+		return err
+	}
+
+	action := newAdminAction(cmd, args)
+	_ = action.SetBefore(newAdminSignStatesHRS(before.PrivValState, before.ShareState))
+	_ = action.SetAfter(newAdminSignStatesHRS(res.PrivValState, res.ShareState))
+	if cluster {
+		recordClusterAdminAction(cmd, replicateWithAdmin(grpcClient), action)
+	} else {
+		recordAdminAction(cmd, action)
+	}
+	return nil
 }
 
 func importStateCmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:     "import [height]",
 		Aliases: []string{"i"},
 		Short: "Read the old priv_validator_state.json and set the height, round and step" +
 			"(good for migrations but NOT shared state update)",
+		Long: "Read the old priv_validator_state.json and set the height, round and step.\n" +
+			"The state is pasted, read from a CometBFT or tmkms state file with --file, or taken from\n" +
+			"the latest commit of a node with --from-node. --dry-run shows the change without making it.\n" +
+			"With --cluster, the sign state is set on all cosigners at the same raft log entry,\n" +
+			"signing must be paused first with horcrux admin pause --cluster.",
+		Example: `horcrux state import
+horcrux state import --file ~/.gaia/data/priv_validator_state.json
+horcrux state import --file /var/lib/tmkms/state/cosmoshub-4-consensus.json --dry-run
+horcrux state import --from-node http://sentry-1:26657 --cluster`,
 		Args:         cobra.ExactArgs(0),
 		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -141,6 +485,56 @@ func importStateCmd() *cobra.Command {
 				return fmt.Errorf("%s does not exist, initialize config with horcrux config init and try again", config.HomeDir)
 			}
 
+			file, _ := cmd.Flags().GetString("file")
+			format, _ := cmd.Flags().GetString("format")
+			fromNode, _ := cmd.Flags().GetString("from-node")
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+			cluster, _ := cmd.Flags().GetBool("cluster")
+			if file != "" && fromNode != "" {
+				cmd.SilenceUsage = false
+				return fmt.Errorf("--file and --from-node can not be used together")
+			}
+			switch format {
+			case stateFormatAuto, stateFormatCometBFT, stateFormatTMKMS:
+			default:
+				cmd.SilenceUsage = false
+				return fmt.Errorf("invalid state format %s, must be one of %s, %s or %s",
+					format, stateFormatAuto, stateFormatCometBFT, stateFormatTMKMS)
+			}
+
+			if cluster {
+				if config.Config.CosignerConfig == nil {
+					return fmt.Errorf("cosigner configuration is not present in config file")
+				}
+				grpcClient, conn, err := adminGRPCClient()
+				if err != nil {
+					return err
+				}
+				defer conn.Close()
+				signState, err := readImportedSignState(cmd, file, format, fromNode)
+				if err != nil {
+					return err
+				}
+				if dryRun {
+					printClusterSignStates(cmd, getClusterStatus())
+					printImportedSignState(cmd, signState, dryRun)
+					return nil
+				}
+				return setRunningState(cmd, args, grpcClient, signState, true)
+			}
+
+			if dryRun {
+				signState, err := readImportedSignState(cmd, file, format, fromNode)
+				if err != nil {
+					return err
+				}
+				if err := showCurrentState(cmd); err != nil {
+					return err
+				}
+				printImportedSignState(cmd, signState, dryRun)
+				return nil
+			}
+
 			// Resetting the priv_validator_state.json should only be allowed if the
 			// signer is not running.
 			if err := signer.RequireNotRunning(config.PidFile); err != nil {
@@ -159,46 +553,16 @@ func importStateCmd() *cobra.Command {
 				return err
 			}
 
-			// Allow user to paste in priv_validator_state.json
-
-			fmt.Println("IMPORTANT: Your validator should already be STOPPED.  You must copy the latest state..")
-			<-time.After(2 * time.Second)
-			fmt.Println("")
-			fmt.Println("Paste your old priv_validator_state.json.  Input a blank line after the pasted JSON to continue.")
-			fmt.Println("")
-
-			var textBuffer strings.Builder
-
-			scanner := bufio.NewScanner(os.Stdin)
-			for scanner.Scan() {
-				if len(scanner.Text()) == 0 {
-					break
-				}
-				textBuffer.WriteString(scanner.Text())
-			}
-			finalJSON := textBuffer.String()
-
-			pvState := &FilePVLastSignState{}
-
-			err = tmjson.Unmarshal([]byte(finalJSON), &pvState)
+			signState, err := readImportedSignState(cmd, file, format, fromNode)
 			if err != nil {
-				fmt.Println("Error parsing priv_validator_state.json")
 				return err
 			}
 
+			action := newAdminAction(cmd, args)
+			_ = action.SetBefore(newSignStatesHRS(pv, share))
+
 			pv.EphemeralPublic = nil
-			signState := signer.SignStateConsensus{
-				Height:    pvState.Height,
-				Round:     int64(pvState.Round),
-				Step:      pvState.Step,
-				Signature: nil,
-				SignBytes: nil,
-			}
-			fmt.Printf("Saving New Sign State: \n"+
-				"  Height:    %v\n"+
-				"  Round:     %v\n"+
-				"  Step:      %v\n",
-				signState.Height, signState.Round, signState.Step)
+			printImportedSignState(cmd, signState, dryRun)
 
 			err = pv.Save(signState, nil, false)
 			if err != nil {
@@ -210,10 +574,222 @@ func importStateCmd() *cobra.Command {
 				fmt.Printf("error saving share sign state")
 				return err
 			}
-			fmt.Printf("Update Successful\n")
+			fmt.Fprintf(cmd.OutOrStdout(), "Update Successful\n")
+
+			_ = action.SetAfter(newSignStatesHRS(pv, share))
+			recordAdminAction(cmd, action)
 			return nil
 		},
 	}
+	cmd.Flags().Bool("cluster", false, "set the sign state on all cosigners through raft")
+	cmd.Flags().StringP("file", "f", "", "read the state from a CometBFT priv_validator_state.json or tmkms state file")
+	cmd.Flags().String("format", stateFormatAuto, "format of the state file: auto, cometbft or tmkms")
+	cmd.Flags().String("from-node", "",
+		"take the state from the latest commit of the node at this CometBFT RPC address, i.e. http://sentry-1:26657")
+	cmd.Flags().Bool("dry-run", false, "show the current and the imported sign state without changing it")
+	return cmd
+}
+
+// showCurrentState prints the sign state of the running cosigner, or of the state files
+func showCurrentState(cmd *cobra.Command) error {
+	grpcClient, conn, err := runningAdminGRPCClient()
+	if err != nil {
+		return err
+	}
+	if grpcClient != nil {
+		defer conn.Close()
+		return showRunningState(cmd, grpcClient)
+	}
+	for _, f := range []struct{ name, file string }{
+		{"Private Validator State:", config.privValStateFile(config.Config.ChainID)},
+		{"Share Sign State:", config.shareStateFile(config.Config.ChainID)},
+	} {
+		fmt.Fprintln(cmd.OutOrStdout(), f.name)
+		ss, err := signer.LoadSignState(f.file)
+		switch {
+		case os.IsNotExist(err):
+			fmt.Fprintln(cmd.OutOrStdout(), "  not created yet")
+		case err != nil:
+			return err
+		default:
+			printAdminSignState(cmd, &proto.AdminSignState{Height: ss.Height, Round: ss.Round, Step: int32(ss.Step)})
+		}
+	}
+	return nil
+}
+
+func printImportedSignState(cmd *cobra.Command, signState signer.SignStateConsensus, dryRun bool) {
+	if dryRun {
+		fmt.Fprintln(cmd.OutOrStdout(), "Would Save New Sign State:")
+	} else {
+		fmt.Fprintln(cmd.OutOrStdout(), "Saving New Sign State:")
+	}
+	printAdminSignState(cmd, &proto.AdminSignState{
+		Height: signState.Height,
+		Round:  signState.Round,
+		Step:   int32(signState.Step),
+	})
+	if dryRun {
+		fmt.Fprintln(cmd.OutOrStdout(), "Dry run, the sign state was not changed")
+	}
+}
+
+// readImportedSignState reads the sign state to import from the state file, the node, or pasted by the user
+func readImportedSignState(
+	cmd *cobra.Command,
+	file, format, fromNode string,
+) (signer.SignStateConsensus, error) {
+	switch {
+	case file != "":
+		bz, err := os.ReadFile(file)
+		if err != nil {
+			return signer.SignStateConsensus{}, err
+		}
+		signState, err := parseStateFile(bz, format)
+		if err != nil {
+			return signer.SignStateConsensus{}, fmt.Errorf("error parsing %s: %w", file, err)
+		}
+		return signState, nil
+	case fromNode != "":
+		ctx, cancelFunc := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancelFunc()
+		chainID, commit, err := signer.LatestCommit(ctx, fromNode)
+		if err != nil {
+			return signer.SignStateConsensus{}, err
+		}
+		if chainID != config.Config.ChainID {
+			return signer.SignStateConsensus{}, fmt.Errorf("%s is a node of chain %s, not %s",
+				fromNode, chainID, config.Config.ChainID)
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "Latest commit of %s is at height %d round %d\n",
+			fromNode, commit.Height, commit.Round)
+		return commit.SignStateConsensus(), nil
+	default:
+		pvState, err := readPastedSignState(cmd)
+		if err != nil {
+			return signer.SignStateConsensus{}, err
+		}
+		return signer.NewSignStateConsensus(pvState.Height, int64(pvState.Round), pvState.Step), nil
+	}
+}
+
+// readPastedSignState reads the priv_validator_state.json pasted by the user
+func readPastedSignState(cmd *cobra.Command) (*FilePVLastSignState, error) {
+	fmt.Println("IMPORTANT: Your validator should already be STOPPED.  You must copy the latest state..")
+	<-time.After(2 * time.Second)
+	fmt.Println("")
+	fmt.Println("Paste your old priv_validator_state.json.  Input a blank line after the pasted JSON to continue.")
+	fmt.Println("")
+
+	var textBuffer strings.Builder
+
+	scanner := bufio.NewScanner(cmd.InOrStdin())
+	for scanner.Scan() {
+		if len(scanner.Text()) == 0 {
+			break
+		}
+		textBuffer.WriteString(scanner.Text())
+	}
+	finalJSON := textBuffer.String()
+
+	pvState := &FilePVLastSignState{}
+
+	err := tmjson.Unmarshal([]byte(finalJSON), &pvState)
+	if err != nil {
+		fmt.Println("Error parsing priv_validator_state.json")
+		return nil, err
+	}
+	return pvState, nil
+}
+
+const (
+	stateFormatAuto     = "auto"
+	stateFormatCometBFT = "cometbft"
+	stateFormatTMKMS    = "tmkms"
+)
+
+// stateFile holds the fields of both the CometBFT priv_validator_state.json and the tmkms consensus state.
+// CometBFT writes the height as a string and the round as a number, tmkms writes both as strings.
+type stateFile struct {
+	Height  json.RawMessage  `json:"height"`
+	Round   json.RawMessage  `json:"round"`
+	Step    int8             `json:"step"`
+	BlockID *json.RawMessage `json:"block_id"`
+}
+
+// parseStateFile parses a CometBFT priv_validator_state.json or a tmkms consensus state file.
+// With the auto format, a block_id or a round written as a string is a tmkms state.
+func parseStateFile(bz []byte, format string) (signer.SignStateConsensus, error) {
+	var sf stateFile
+	if err := json.Unmarshal(bz, &sf); err != nil {
+		return signer.SignStateConsensus{}, err
+	}
+	if format == stateFormatAuto || format == "" {
+		format = stateFormatCometBFT
+		if sf.BlockID != nil || strings.HasPrefix(string(sf.Round), `"`) {
+			format = stateFormatTMKMS
+		}
+	}
+
+	height, err := parseStateFileInt(sf.Height)
+	if err != nil {
+		return signer.SignStateConsensus{}, fmt.Errorf("invalid height: %w", err)
+	}
+	round, err := parseStateFileInt(sf.Round)
+	if err != nil {
+		return signer.SignStateConsensus{}, fmt.Errorf("invalid round: %w", err)
+	}
+
+	step := sf.Step
+	maxStep := int8(3)
+	if format == stateFormatTMKMS {
+		// tmkms counts the proposal, prevote and precommit steps from 0, CometBFT from 1
+		maxStep = 2
+		step++
+	}
+	if sf.Step < 0 || sf.Step > maxStep {
+		return signer.SignStateConsensus{}, fmt.Errorf("invalid %s step %d", format, sf.Step)
+	}
+	return signer.NewSignStateConsensus(height, round, step), nil
+}
+
+// parseStateFileInt parses an integer written either as a JSON number or as a string
+func parseStateFileInt(raw json.RawMessage) (int64, error) {
+	if len(raw) == 0 {
+		return 0, fmt.Errorf("missing")
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err != nil {
+		s = string(raw)
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return 0, err
+	}
+	if n < 0 {
+		return 0, fmt.Errorf("%d is negative", n)
+	}
+	return n, nil
+}
+
+// signStatesHRS are the sign states changed by an admin action
+type signStatesHRS struct {
+	PrivVal ClusterStatusHRS `json:"privval"`
+	Share   ClusterStatusHRS `json:"share"`
+}
+
+func newSignStatesHRS(pv, share signer.SignState) signStatesHRS {
+	return signStatesHRS{
+		PrivVal: ClusterStatusHRS{Height: pv.Height, Round: pv.Round, Step: int32(pv.Step)},
+		Share:   ClusterStatusHRS{Height: share.Height, Round: share.Round, Step: int32(share.Step)},
+	}
+}
+
+func newAdminSignStatesHRS(pv, share *proto.AdminSignState) signStatesHRS {
+	return signStatesHRS{
+		PrivVal: ClusterStatusHRS{Height: pv.GetHeight(), Round: pv.GetRound(), Step: pv.GetStep()},
+		Share:   ClusterStatusHRS{Height: share.GetHeight(), Round: share.GetRound(), Step: share.GetStep()},
+	}
 }
 
 func printSignState(ss signer.SignState) {
@@ -229,6 +805,6 @@ func printSignState(ss signer.SignState) {
 		fmt.Println("  Signature:", base64.StdEncoding.EncodeToString(ss.Signature))
 	}
 	if ss.SignBytes != nil {
-		fmt.Println("  SignBytes:", ss.SignBytes)
+		printSignBytes(os.Stdout, ss.SignBytes)
 	}
 }
@@ -1,7 +1,9 @@
 package cmd
 
 import (
+	"bytes"
 	"io"
+	"os"
 	"path/filepath"
 	"strconv"
 	"testing"
@@ -86,4 +88,226 @@ func TestStateSetCmd(t *testing.T) {
 			}
 		})
 	}
+
+	// only the valid height has been recorded
+	actions, err := signer.ReadAdminActions(filepath.Join(tmpConfig, "state", "admin_audit.jsonl"))
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	require.Equal(t, "set", actions[0].Command)
+	require.Equal(t, "123456789", actions[0].Params["args"])
+	require.JSONEq(t, `{"privval":{"height":0,"round":0,"step":0},"share":{"height":0,"round":0,"step":0}}`,
+		string(actions[0].Before))
+	require.JSONEq(t, `{"privval":{"height":123456789,"round":0,"step":0},`+
+		`"share":{"height":123456789,"round":0,"step":0}}`, string(actions[0].After))
+}
+
+func TestSignStateDivergence(t *testing.T) {
+	nodes := []ClusterStatusNode{
+		{
+			Address:        "tcp://10.168.1.1:2222",
+			ShareID:        1,
+			LastSignState:  &ClusterStatusHRS{Height: 100, Round: 0, Step: 3},
+			ShareSignState: &ClusterStatusHRS{Height: 100, Round: 0, Step: 3},
+		},
+		{
+			Address:        "tcp://10.168.1.2:2222",
+			ShareID:        2,
+			LastSignState:  &ClusterStatusHRS{Height: 100, Round: 0, Step: 3},
+			ShareSignState: &ClusterStatusHRS{Height: 100, Round: 0, Step: 3},
+		},
+		{
+			Address:       "tcp://10.168.1.4:2222",
+			ShareID:       4,
+			Witness:       true,
+			LastSignState: &ClusterStatusHRS{Height: 100, Round: 0, Step: 3},
+		},
+	}
+	require.Empty(t, signStateDivergence(nodes))
+
+	nodes[1].LastSignState = &ClusterStatusHRS{Height: 100, Round: 0, Step: 2}
+	nodes[1].ShareSignState = &ClusterStatusHRS{Height: 99, Round: 1, Step: 3}
+	nodes = append(nodes, ClusterStatusNode{Address: "tcp://10.168.1.3:2222", Error: "connection refused"})
+	require.Equal(t, []string{
+		"cosigner 2 privval sign state 100/0/2 is behind 100/0/3",
+		"cosigner 2 share sign state 99/1/3 is behind 100/0/3",
+		"cosigner at tcp://10.168.1.3:2222 is unreachable",
+	}, signStateDivergence(nodes))
+}
+
+func TestParseStateFile(t *testing.T) {
+	tcs := []struct {
+		name      string
+		state     string
+		format    string
+		expect    signer.SignStateConsensus
+		expectErr bool
+	}{
+		{
+			name:   "cometbft",
+			state:  `{"height":"1500000","round":1,"step":3,"signature":"AAAA","signbytes":"AAAA"}`,
+			format: stateFormatAuto,
+			expect: signer.NewSignStateConsensus(1500000, 1, 3),
+		},
+		{
+			name:   "tmkms",
+			state:  `{"height":"1500000","round":"1","step":2,"block_id":null}`,
+			format: stateFormatAuto,
+			expect: signer.NewSignStateConsensus(1500000, 1, 3),
+		},
+		{
+			name:   "tmkms proposal",
+			state:  `{"height":"1500000","round":"0","step":0}`,
+			format: stateFormatAuto,
+			expect: signer.NewSignStateConsensus(1500000, 0, 1),
+		},
+		{
+			name:   "forced tmkms format",
+			state:  `{"height":"1500000","round":0,"step":1}`,
+			format: stateFormatTMKMS,
+			expect: signer.NewSignStateConsensus(1500000, 0, 2),
+		},
+		{
+			name:      "invalid step",
+			state:     `{"height":"1500000","round":"0","step":3,"block_id":null}`,
+			format:    stateFormatAuto,
+			expectErr: true,
+		},
+		{
+			name:      "negative height",
+			state:     `{"height":"-1","round":0,"step":0}`,
+			format:    stateFormatCometBFT,
+			expectErr: true,
+		},
+		{
+			name:      "missing height",
+			state:     `{"round":0,"step":0}`,
+			format:    stateFormatCometBFT,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			signState, err := parseStateFile([]byte(tc.state), tc.format)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expect, signState)
+		})
+	}
+}
+
+func TestStateImportCmd(t *testing.T) {
+	tmpHome := t.TempDir()
+	tmpConfig := filepath.Join(tmpHome, ".horcrux")
+	chainid := "horcrux-1"
+
+	t.Setenv("HOME", tmpHome)
+
+	cmd := initCmd()
+	cmd.SetOutput(io.Discard)
+	cmd.SetArgs([]string{
+		chainid,
+		"tcp://10.168.0.1:1234",
+		"-c",
+		"-t", "2",
+		"-p", "tcp://10.168.1.2:2222|2,tcp://10.168.1.3:2222|3",
+		"-l", "tcp://10.168.1.1:2222",
+		"--timeout", "1500ms",
+	})
+	require.NoError(t, cmd.Execute())
+
+	stateFile := filepath.Join(tmpHome, "priv_validator_state.json")
+	require.NoError(t, os.WriteFile(stateFile, []byte(`{"height":"1500000","round":"2","step":1}`), 0600))
+
+	privValStateFile := filepath.Join(tmpConfig, "state", chainid+"_priv_validator_state.json")
+	shareStateFile := filepath.Join(tmpConfig, "state", chainid+"_share_sign_state.json")
+
+	// a dry run changes nothing
+	var out bytes.Buffer
+	cmd = importStateCmd()
+	cmd.SetOutput(&out)
+	cmd.SetArgs([]string{"--file", stateFile, "--dry-run"})
+	require.NoError(t, cmd.Execute())
+	require.Contains(t, out.String(), "Height:    1500000")
+	require.Contains(t, out.String(), "Dry run, the sign state was not changed")
+	ss, err := signer.LoadSignState(shareStateFile)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), ss.Height)
+
+	cmd = importStateCmd()
+	cmd.SetOutput(io.Discard)
+	cmd.SetArgs([]string{"--file", stateFile})
+	require.NoError(t, cmd.Execute())
+
+	for _, file := range []string{privValStateFile, shareStateFile} {
+		ss, err := signer.LoadSignState(file)
+		require.NoError(t, err)
+		require.Equal(t, int64(1500000), ss.Height)
+		require.Equal(t, int64(2), ss.Round)
+		require.Equal(t, int8(2), ss.Step)
+	}
+
+	cmd = importStateCmd()
+	cmd.SetOutput(io.Discard)
+	cmd.SetArgs([]string{"--file", stateFile, "--from-node", "http://127.0.0.1:26657"})
+	require.Error(t, cmd.Execute())
+}
+
+func TestStateMigrateCmd(t *testing.T) {
+	tmpHome := t.TempDir()
+	stateDir := filepath.Join(tmpHome, ".horcrux", "state")
+	chainid := "horcrux-1"
+
+	t.Setenv("HOME", tmpHome)
+
+	cmd := initCmd()
+	cmd.SetOutput(io.Discard)
+	cmd.SetArgs([]string{
+		chainid,
+		"tcp://10.168.0.1:1234",
+		"-c",
+		"-t", "2",
+		"-p", "tcp://10.168.1.2:2222|2,tcp://10.168.1.3:2222|3",
+		"-l", "tcp://10.168.1.1:2222",
+		"--timeout", "1500ms",
+	})
+	require.NoError(t, cmd.Execute())
+
+	cmd = setStateCmd()
+	cmd.SetOutput(io.Discard)
+	cmd.SetArgs([]string{"1500000"})
+	require.NoError(t, cmd.Execute())
+
+	cmd = migrateStateCmd()
+	cmd.SetOutput(io.Discard)
+	cmd.SetArgs([]string{"--to", "boltdb"})
+	require.NoError(t, cmd.Execute())
+	require.Equal(t, signStateBackendBoltDB, config.Config.SignStateBackend)
+
+	for _, name := range []string{"_priv_validator_state", "_share_sign_state"} {
+		_, err := os.Stat(filepath.Join(stateDir, chainid+name+".json"))
+		require.True(t, os.IsNotExist(err))
+
+		ss, err := signer.LoadSignState(filepath.Join(stateDir, chainid+name+".db"))
+		require.NoError(t, err)
+		require.Equal(t, int64(1500000), ss.Height)
+		require.NoError(t, ss.Close())
+	}
+
+	cmd = migrateStateCmd()
+	cmd.SetOutput(io.Discard)
+	cmd.SetArgs([]string{"--to", "boltdb"})
+	require.Error(t, cmd.Execute())
+
+	cmd = migrateStateCmd()
+	cmd.SetOutput(io.Discard)
+	cmd.SetArgs([]string{"--to", "json"})
+	require.NoError(t, cmd.Execute())
+
+	ss, err := signer.LoadSignState(filepath.Join(stateDir, chainid+"_share_sign_state.json"))
+	require.NoError(t, err)
+	require.Equal(t, int64(1500000), ss.Height)
 }
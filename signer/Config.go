@@ -29,6 +29,7 @@ type Config struct {
 	PrivValStateDir   string
 	ChainID           string
 	CosignerThreshold int
+	CosignerShares    int
 	ListenAddress     string
 	Nodes             []NodeConfig
 	Cosigners         []CosignerConfig
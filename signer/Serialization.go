@@ -2,7 +2,10 @@ package signer
 
 import (
 	"errors"
+	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/tendermint/tendermint/libs/protoio"
 	tmProtoPrivval "github.com/tendermint/tendermint/proto/tendermint/privval"
@@ -42,3 +45,165 @@ func UnpackHRST(signBytes []byte) (HRSTKey, error) {
 
 	return HRSTKey{0, 0, 0, 0}, errors.New("could not UnpackHRS from sign bytes")
 }
+
+// ChainIDMismatchError is returned when the sign bytes are for a different chain than the one
+// the cosigner is configured for
+type ChainIDMismatchError struct {
+	msg string
+}
+
+func (e *ChainIDMismatchError) Error() string { return e.msg }
+
+func newChainIDMismatchError(expected, actual string) *ChainIDMismatchError {
+	return &ChainIDMismatchError{
+		msg: fmt.Sprintf("sign bytes are for chain ID %q, expected %q", actual, expected),
+	}
+}
+
+// HRSTMismatchError is returned when the height, round, step and timestamp of a sign request
+// do not match the ones in its sign bytes
+type HRSTMismatchError struct {
+	msg string
+}
+
+func (e *HRSTMismatchError) Error() string { return e.msg }
+
+func newHRSTMismatchError(request, signBytes HRSTKey) *HRSTMismatchError {
+	return &HRSTMismatchError{
+		msg: fmt.Sprintf("request is for %d.%d.%d at %d, but sign bytes are for %d.%d.%d at %d",
+			request.Height, request.Round, request.Step, request.Timestamp,
+			signBytes.Height, signBytes.Round, signBytes.Step, signBytes.Timestamp),
+	}
+}
+
+// InvalidSignBytesError is returned when the sign bytes are not a canonical vote or proposal
+type InvalidSignBytesError struct {
+	msg string
+}
+
+func (e *InvalidSignBytesError) Error() string { return e.msg }
+
+func newInvalidSignBytesError(format string, args ...interface{}) *InvalidSignBytesError {
+	return &InvalidSignBytesError{
+		msg: fmt.Sprintf(format, args...),
+	}
+}
+
+// SignBytesContent is the content of fully decoded sign bytes
+type SignBytesContent struct {
+	ChainID string
+	Type    tmProto.SignedMsgType
+	HRST    HRSTKey
+
+	// nil for a vote on nil
+	BlockID *tmProto.CanonicalBlockID
+
+	// proof of lock round of a proposal, -1 for votes
+	POLRound int64
+}
+
+// DecodeSignBytes fully decodes sign bytes as a canonical proposal or vote.
+// Returns an InvalidSignBytesError if they are neither, or if the message type is not valid.
+func DecodeSignBytes(signBytes []byte) (SignBytesContent, error) {
+	var proposal tmProto.CanonicalProposal
+	if err := protoio.UnmarshalDelimited(signBytes, &proposal); err == nil && proposal.Type == tmProto.ProposalType {
+		return SignBytesContent{
+			ChainID:  proposal.ChainID,
+			Type:     proposal.Type,
+			HRST:     HRSTKey{proposal.Height, proposal.Round, stepPropose, proposal.Timestamp.UnixNano()},
+			BlockID:  proposal.BlockID,
+			POLRound: proposal.POLRound,
+		}, nil
+	}
+
+	var vote tmProto.CanonicalVote
+	if err := protoio.UnmarshalDelimited(signBytes, &vote); err != nil {
+		return SignBytesContent{}, newInvalidSignBytesError("sign bytes are not a canonical vote or proposal: %v", err)
+	}
+	if vote.Type != tmProto.PrevoteType && vote.Type != tmProto.PrecommitType {
+		return SignBytesContent{}, newInvalidSignBytesError("invalid vote type in sign bytes: %d", vote.Type)
+	}
+	return SignBytesContent{
+		ChainID:  vote.ChainID,
+		Type:     vote.Type,
+		HRST:     HRSTKey{vote.Height, vote.Round, CanonicalVoteToStep(&vote), vote.Timestamp.UnixNano()},
+		BlockID:  vote.BlockID,
+		POLRound: -1,
+	}, nil
+}
+
+// BlockIDHash returns the hash of the block ID in hex, "nil" for a vote on nil
+func (c SignBytesContent) BlockIDHash() string {
+	if c.BlockID == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("%X", c.BlockID.Hash)
+}
+
+// PartSetHeader returns the total and the hash of the part set header of the block ID, "nil" for a vote on nil
+func (c SignBytesContent) PartSetHeader() string {
+	if c.BlockID == nil {
+		return "nil"
+	}
+	return fmt.Sprintf("%d:%X", c.BlockID.PartSetHeader.Total, c.BlockID.PartSetHeader.Hash)
+}
+
+// Timestamp returns the timestamp of the proposal or vote
+func (c SignBytesContent) Timestamp() time.Time {
+	return time.Unix(0, c.HRST.Timestamp).UTC()
+}
+
+// TypeName returns the short name of the message type, i.e. Precommit
+func (c SignBytesContent) TypeName() string {
+	switch c.Type {
+	case tmProto.ProposalType:
+		return "Proposal"
+	case tmProto.PrevoteType:
+		return "Prevote"
+	case tmProto.PrecommitType:
+		return "Precommit"
+	default:
+		return c.Type.String()
+	}
+}
+
+// String renders the proposal or vote on a single line, i.e. for the logs
+func (c SignBytesContent) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s height=%d round=%d block_id=%s part_set_header=%s",
+		c.TypeName(), c.HRST.Height, c.HRST.Round, c.BlockIDHash(), c.PartSetHeader())
+	if c.Type == tmProto.ProposalType {
+		fmt.Fprintf(&sb, " pol_round=%d", c.POLRound)
+	}
+	fmt.Fprintf(&sb, " timestamp=%s chain_id=%s", c.Timestamp().Format(time.RFC3339Nano), c.ChainID)
+	return sb.String()
+}
+
+// ReadableSignBytes renders sign bytes as the decoded proposal or vote when logged,
+// and as hex if they can not be decoded. They are only decoded if the log line is written.
+type ReadableSignBytes []byte
+
+func (b ReadableSignBytes) String() string {
+	content, err := DecodeSignBytes(b)
+	if err != nil {
+		return fmt.Sprintf("%X (%v)", []byte(b), err)
+	}
+	return content.String()
+}
+
+// ValidateSignBytes checks that the sign bytes are a canonical vote or proposal for the chain ID
+// and for the height, round, step and timestamp of the request.
+// An empty chain ID skips the chain ID check.
+func ValidateSignBytes(chainID string, hrst HRSTKey, signBytes []byte) error {
+	content, err := DecodeSignBytes(signBytes)
+	if err != nil {
+		return err
+	}
+	if chainID != "" && content.ChainID != chainID {
+		return newChainIDMismatchError(chainID, content.ChainID)
+	}
+	if content.HRST != hrst {
+		return newHRSTMismatchError(hrst, content.HRST)
+	}
+	return nil
+}
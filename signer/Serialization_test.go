@@ -1,7 +1,10 @@
 package signer
 
 import (
+	"bytes"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -55,3 +58,105 @@ func TestUnpackHRSProposal(t *testing.T) {
 	require.Equal(t, int64(2), hrs.Round)
 	require.Equal(t, int8(1), hrs.Step)
 }
+
+func TestDecodeSignBytes(t *testing.T) {
+	vote := tmproto.Vote{
+		Height: 3,
+		Round:  2,
+		Type:   tmproto.PrecommitType,
+	}
+	content, err := DecodeSignBytes(tm.VoteSignBytes("chain-id", &vote))
+	require.NoError(t, err)
+	require.Equal(t, "chain-id", content.ChainID)
+	require.Equal(t, tmproto.PrecommitType, content.Type)
+	require.Equal(t, int64(3), content.HRST.Height)
+	require.Equal(t, int64(2), content.HRST.Round)
+	require.Equal(t, int8(3), content.HRST.Step)
+
+	proposal := tmproto.Proposal{
+		Height: 1,
+		Round:  2,
+		Type:   tmproto.ProposalType,
+	}
+	content, err = DecodeSignBytes(tm.ProposalSignBytes("chain-id", &proposal))
+	require.NoError(t, err)
+	require.Equal(t, "chain-id", content.ChainID)
+	require.Equal(t, tmproto.ProposalType, content.Type)
+	require.Equal(t, int8(1), content.HRST.Step)
+
+	vote.Type = tmproto.ProposalType
+	_, err = DecodeSignBytes(tm.VoteSignBytes("chain-id", &vote))
+	require.IsType(t, &InvalidSignBytesError{}, err)
+
+	_, err = DecodeSignBytes([]byte("not sign bytes"))
+	require.IsType(t, &InvalidSignBytesError{}, err)
+}
+
+func TestSignBytesContentString(t *testing.T) {
+	timestamp := time.Date(2022, 4, 15, 5, 20, 0, 0, time.UTC)
+	blockHash := bytes.Repeat([]byte{0xAB}, 32)
+	partsHash := bytes.Repeat([]byte{0xEF}, 32)
+	vote := tmproto.Vote{
+		Height:    3,
+		Round:     2,
+		Type:      tmproto.PrecommitType,
+		Timestamp: timestamp,
+		BlockID: tmproto.BlockID{
+			Hash:          blockHash,
+			PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: partsHash},
+		},
+	}
+	require.Equal(t, fmt.Sprintf("Precommit height=3 round=2 block_id=%X part_set_header=1:%X ", blockHash, partsHash)+
+		"timestamp=2022-04-15T05:20:00Z chain_id=chain-id",
+		ReadableSignBytes(tm.VoteSignBytes("chain-id", &vote)).String())
+
+	// a vote on nil
+	vote.Type = tmproto.PrevoteType
+	vote.BlockID = tmproto.BlockID{}
+	require.Equal(t, "Prevote height=3 round=2 block_id=nil part_set_header=nil "+
+		"timestamp=2022-04-15T05:20:00Z chain_id=chain-id",
+		ReadableSignBytes(tm.VoteSignBytes("chain-id", &vote)).String())
+
+	proposal := tmproto.Proposal{
+		Height:    1,
+		Round:     2,
+		Type:      tmproto.ProposalType,
+		PolRound:  1,
+		Timestamp: timestamp,
+		BlockID: tmproto.BlockID{
+			Hash:          blockHash,
+			PartSetHeader: tmproto.PartSetHeader{Total: 2, Hash: partsHash},
+		},
+	}
+	require.Equal(t, fmt.Sprintf("Proposal height=1 round=2 block_id=%X part_set_header=2:%X pol_round=1 ",
+		blockHash, partsHash)+
+		"timestamp=2022-04-15T05:20:00Z chain_id=chain-id",
+		ReadableSignBytes(tm.ProposalSignBytes("chain-id", &proposal)).String())
+
+	require.Contains(t, ReadableSignBytes([]byte{0x01, 0x02}).String(), "0102 (")
+}
+
+func TestValidateSignBytes(t *testing.T) {
+	vote := tmproto.Vote{
+		Height:    3,
+		Round:     2,
+		Type:      tmproto.PrevoteType,
+		Timestamp: time.Now(),
+	}
+	signBytes := tm.VoteSignBytes("chain-id", &vote)
+	hrst := HRSTKey{Height: 3, Round: 2, Step: stepPrevote, Timestamp: vote.Timestamp.UnixNano()}
+
+	require.NoError(t, ValidateSignBytes("chain-id", hrst, signBytes))
+	require.NoError(t, ValidateSignBytes("", hrst, signBytes))
+
+	err := ValidateSignBytes("other-chain-id", hrst, signBytes)
+	require.IsType(t, &ChainIDMismatchError{}, err)
+
+	err = ValidateSignBytes("chain-id",
+		HRSTKey{Height: 4, Round: 2, Step: stepPrevote, Timestamp: hrst.Timestamp}, signBytes)
+	require.IsType(t, &HRSTMismatchError{}, err)
+
+	err = ValidateSignBytes("chain-id",
+		HRSTKey{Height: 3, Round: 2, Step: stepPrecommit, Timestamp: hrst.Timestamp}, signBytes)
+	require.IsType(t, &HRSTMismatchError{}, err)
+}
@@ -0,0 +1,156 @@
+package signer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"time"
+)
+
+// AdminAction is an administrative operation that changed safety critical state,
+// such as the sign state, the cosigner peers or the raft leader.
+type AdminAction struct {
+	Time time.Time `json:"time"`
+
+	// OS user and host that ran the command
+	User string `json:"user"`
+	Host string `json:"host"`
+
+	// horcrux command, i.e. state set
+	Command string            `json:"command"`
+	Params  map[string]string `json:"params,omitempty"`
+
+	Before json.RawMessage `json:"before,omitempty"`
+	After  json.RawMessage `json:"after,omitempty"`
+
+	// true if the action affects the whole cluster and was replicated through raft
+	Cluster bool `json:"cluster,omitempty"`
+}
+
+// NewAdminAction returns an action for the command run by the current OS user on this host
+func NewAdminAction(command string, params map[string]string) AdminAction {
+	action := AdminAction{
+		Time:    time.Now().UTC(),
+		User:    os.Getenv("USER"),
+		Command: command,
+		Params:  params,
+	}
+	if u, err := user.Current(); err == nil {
+		action.User = u.Username
+	}
+	if host, err := os.Hostname(); err == nil {
+		action.Host = host
+	}
+	return action
+}
+
+// sameAs returns true if both are the same run of a command
+func (a AdminAction) sameAs(other AdminAction) bool {
+	return a.Time.Equal(other.Time) && a.User == other.User && a.Host == other.Host && a.Command == other.Command
+}
+
+// SetBefore sets the value before the action was run
+func (a *AdminAction) SetBefore(v interface{}) error {
+	before, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	a.Before = before
+	return nil
+}
+
+// SetAfter sets the value after the action was run
+func (a *AdminAction) SetAfter(v interface{}) error {
+	after, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	a.After = after
+	return nil
+}
+
+// AppendAdminAction appends the action as a JSON line to the admin audit file
+func AppendAdminAction(filePath string, action AdminAction) error {
+	line, err := json.Marshal(action)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ReadAdminActions returns the actions in the admin audit file, oldest first.
+// Returns no actions if the file does not exist.
+func ReadAdminActions(filePath string) ([]AdminAction, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var actions []AdminAction
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var action AdminAction
+		if err := json.Unmarshal(scanner.Bytes(), &action); err != nil {
+			return nil, fmt.Errorf("line %d of %s: %w", line, filePath, err)
+		}
+		actions = append(actions, action)
+	}
+	return actions, scanner.Err()
+}
+
+// RecordAdminAction replicates the cluster affecting action to every node of the cluster,
+// which append it to their admin audit file. Must be called on the leader.
+func (s *RaftStore) RecordAdminAction(action AdminAction) error {
+	if action.Command == "" {
+		return errors.New("admin action has no command")
+	}
+	action.Cluster = true
+	return s.Emit(raftEventAdminAction, action)
+}
+
+func (f *fsm) handleAdminActionEvent(value string) {
+	if f.AdminAuditFile == "" {
+		return
+	}
+	var action AdminAction
+	if err := json.Unmarshal([]byte(value), &action); err != nil {
+		f.logger.Error("Admin action Unmarshal Error", err.Error())
+		return
+	}
+	// raft replays the log entries after the last snapshot on restart
+	recorded, err := ReadAdminActions(f.AdminAuditFile)
+	if err != nil {
+		f.logger.Error("Failed to read admin audit file", "file", f.AdminAuditFile, "error", err)
+		return
+	}
+	for _, r := range recorded {
+		if r.sameAs(action) {
+			return
+		}
+	}
+	if err := AppendAdminAction(f.AdminAuditFile, action); err != nil {
+		f.logger.Error("Failed to write admin action to audit file", "file", f.AdminAuditFile, "error", err)
+		return
+	}
+	f.logger.Info("Recorded cluster admin action", "command", action.Command, "user", action.User, "host", action.Host)
+}
@@ -0,0 +1,49 @@
+package signer
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+)
+
+func TestAdminActions(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "admin_audit.jsonl")
+
+	actions, err := ReadAdminActions(filePath)
+	require.NoError(t, err)
+	require.Empty(t, actions)
+
+	action := NewAdminAction("state set", map[string]string{"args": "100"})
+	require.NotEmpty(t, action.User)
+	require.NotEmpty(t, action.Host)
+	require.NoError(t, action.SetBefore(HRSTKey{Height: 10}))
+	require.NoError(t, action.SetAfter(HRSTKey{Height: 100}))
+	require.NoError(t, AppendAdminAction(filePath, action))
+
+	actions, err = ReadAdminActions(filePath)
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	require.Equal(t, "state set", actions[0].Command)
+	require.Equal(t, "100", actions[0].Params["args"])
+	require.JSONEq(t, `{"Height":10,"Round":0,"Step":0,"Timestamp":0}`, string(actions[0].Before))
+	require.JSONEq(t, `{"Height":100,"Round":0,"Step":0,"Timestamp":0}`, string(actions[0].After))
+}
+
+func TestAdminActionEventReplay(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "admin_audit.jsonl")
+	f := &fsm{AdminAuditFile: filePath, logger: tmlog.NewNopLogger()}
+
+	value := `{"time":"2022-01-02T03:04:05Z","user":"ops","host":"admin-1","command":"elect","cluster":true}`
+	f.handleAdminActionEvent(value)
+
+	// raft replays the entry after a restart
+	f.handleAdminActionEvent(value)
+
+	actions, err := ReadAdminActions(filePath)
+	require.NoError(t, err)
+	require.Len(t, actions, 1)
+	require.Equal(t, "elect", actions[0].Command)
+	require.True(t, actions[0].Cluster)
+}
@@ -0,0 +1,558 @@
+package signer
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	proto "github.com/strangelove-ventures/horcrux/signer/proto"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// adminSetStateTimeout is how long SetState waits for the sign requests in progress when signing was paused
+const adminSetStateTimeout = 10 * time.Second
+
+// ParseAdminAddress returns the network and address to listen on for an admin API address,
+// which is either unix:///path/to/socket or tcp://host:port with a loopback host.
+func ParseAdminAddress(address string) (network, addr string, err error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", "", fmt.Errorf("error parsing admin address %s: %w", address, err)
+	}
+	switch u.Scheme {
+	case "unix":
+		path := u.Host + u.Path
+		if path == "" {
+			return "", "", fmt.Errorf("admin address %s has no socket path", address)
+		}
+		return "unix", path, nil
+	case "tcp":
+		host, _, err := net.SplitHostPort(u.Host)
+		if err != nil {
+			return "", "", fmt.Errorf("error parsing admin address %s: %w", address, err)
+		}
+		if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+			return "", "", fmt.Errorf("admin address %s must listen on localhost", address)
+		}
+		return "tcp", u.Host, nil
+	default:
+		return "", "", fmt.Errorf("admin address %s must start with unix:// or tcp://", address)
+	}
+}
+
+// AdminDialTarget returns the gRPC target to dial the admin API at the address
+func AdminDialTarget(address string) (string, error) {
+	network, addr, err := ParseAdminAddress(address)
+	if err != nil {
+		return "", err
+	}
+	if network == "unix" {
+		return "unix://" + addr, nil
+	}
+	return addr, nil
+}
+
+// LoadOrCreateAdminToken reads the token that clients of the admin API must present,
+// generating a random one readable only by the owner if the file does not exist.
+func LoadOrCreateAdminToken(filePath string) (string, error) {
+	token, err := LoadAdminToken(filePath)
+	if err == nil || !errors.Is(err, os.ErrNotExist) {
+		return token, err
+	}
+	bz := make([]byte, 32)
+	if _, err := rand.Read(bz); err != nil {
+		return "", err
+	}
+	token = hex.EncodeToString(bz)
+	if err := os.WriteFile(filePath, []byte(token+"\n"), 0600); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// LoadAdminToken reads the token that clients of the admin API must present
+func LoadAdminToken(filePath string) (string, error) {
+	bz, err := os.ReadFile(filePath)
+	if err != nil {
+		return "", fmt.Errorf("error reading admin token: %w", err)
+	}
+	token := strings.TrimSpace(string(bz))
+	if token == "" {
+		return "", fmt.Errorf("admin token file %s is empty", filePath)
+	}
+	return token, nil
+}
+
+// AdminTokenCredentials sends the admin token with every request to the admin API
+type AdminTokenCredentials string
+
+// GetRequestMetadata implements credentials.PerRPCCredentials
+func (t AdminTokenCredentials) GetRequestMetadata(context.Context, ...string) (map[string]string, error) {
+	return map[string]string{"authorization": "Bearer " + string(t)}, nil
+}
+
+// RequireTransportSecurity implements credentials.PerRPCCredentials.
+// The admin API only listens on a unix socket or on localhost.
+func (t AdminTokenCredentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// adminAuthInterceptor refuses requests that do not carry the admin token
+func adminAuthInterceptor(token string) grpc.UnaryServerInterceptor {
+	expected := []byte("Bearer " + token)
+	return func(
+		ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		for _, auth := range md.Get("authorization") {
+			if subtle.ConstantTimeCompare([]byte(auth), expected) == 1 {
+				return handler(ctx, req)
+			}
+		}
+		return nil, status.Error(codes.Unauthenticated, "missing or invalid admin token")
+	}
+}
+
+// AdminServiceConfig is what the admin API of a cosigner operates on
+type AdminServiceConfig struct {
+	// unix:///path/to/socket or tcp://localhost:port
+	ListenAddress string
+
+	// token that clients must present
+	Token string
+
+	ChainID            string
+	Pause              *SigningPause
+	ThresholdValidator *ThresholdValidator
+	Cosigner           *LocalCosigner
+
+	// nil in leaderless mode
+	RaftStore *RaftStore
+
+	// connect to the sentries once the cosigner is ready, may not be started yet
+	RemoteSigners *RemoteSigners
+
+	// re-reads the config file and applies the changes that do not require a restart,
+	// returning a description of each change. Reloading is not supported if nil.
+	ReloadConfig func() ([]string, error)
+}
+
+// AdminService serves the admin API of a cosigner to the operator on the local host
+type AdminService struct {
+	service.BaseService
+
+	logger log.Logger
+	server *grpc.Server
+	cfg    AdminServiceConfig
+}
+
+// NewAdminService returns a new AdminService
+func NewAdminService(logger log.Logger, cfg AdminServiceConfig) *AdminService {
+	s := &AdminService{
+		logger: logger,
+		cfg:    cfg,
+	}
+	s.BaseService = *service.NewBaseService(logger, "AdminService", s)
+	return s
+}
+
+// OnStart starts the gRPC server of the admin API
+func (s *AdminService) OnStart() error {
+	network, addr, err := ParseAdminAddress(s.cfg.ListenAddress)
+	if err != nil {
+		return err
+	}
+	if network == "unix" {
+		// a socket left behind by a process that was killed, the PID file guards against a running one
+		if fi, err := os.Stat(addr); err == nil && fi.Mode()&os.ModeSocket != 0 {
+			if err := os.Remove(addr); err != nil {
+				return err
+			}
+		}
+	}
+	sock, err := net.Listen(network, addr)
+	if err != nil {
+		return err
+	}
+	if network == "unix" {
+		if err := os.Chmod(addr, 0600); err != nil {
+			sock.Close()
+			return err
+		}
+	}
+	s.logger.Info("Admin gRPC Listening", "address", s.cfg.ListenAddress)
+
+	s.server = grpc.NewServer(grpc.UnaryInterceptor(adminAuthInterceptor(s.cfg.Token)))
+	proto.RegisterAdminGRPCServer(s.server, &AdminGRPCServer{service: s})
+
+	go func() {
+		if err := s.server.Serve(sock); err != nil {
+			s.logger.Error("Admin gRPC server stopped", "error", err)
+		}
+	}()
+	return nil
+}
+
+// OnStop stops the gRPC server of the admin API
+func (s *AdminService) OnStop() {
+	s.server.Stop()
+}
+
+// AdminGRPCServer implements the admin API
+type AdminGRPCServer struct {
+	service *AdminService
+	proto.UnimplementedAdminGRPCServer
+}
+
+// Pause stops signing on this cosigner, or on all cosigners through the raft leader
+func (rpc *AdminGRPCServer) Pause(
+	ctx context.Context,
+	req *proto.AdminGRPCPauseRequest,
+) (*proto.AdminGRPCPauseResponse, error) {
+	if req.GetCluster() {
+		err := rpc.setClusterPause(ctx, &proto.CosignerGRPCSetClusterPauseRequest{
+			Paused:       true,
+			Reason:       req.GetReason(),
+			ResumeHeight: req.GetResumeHeight(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		rpc.service.logger.Info("Signing paused on all cosigners",
+			"reason", req.GetReason(), "resume_height", req.GetResumeHeight())
+		return &proto.AdminGRPCPauseResponse{}, nil
+	}
+	if req.GetResumeHeight() != 0 {
+		return nil, status.Error(codes.InvalidArgument, "a resume height is only supported when pausing the cluster")
+	}
+	rpc.service.cfg.Pause.Pause(req.GetReason())
+	rpc.service.logger.Info("Signing paused", "reason", req.GetReason())
+	return &proto.AdminGRPCPauseResponse{}, nil
+}
+
+// Resume allows signing again on this cosigner, or on all cosigners through the raft leader
+func (rpc *AdminGRPCServer) Resume(
+	ctx context.Context,
+	req *proto.AdminGRPCResumeRequest,
+) (*proto.AdminGRPCResumeResponse, error) {
+	if req.GetCluster() {
+		wasPaused := rpc.service.cfg.Pause.ClusterPause().Paused
+		if err := rpc.setClusterPause(ctx, &proto.CosignerGRPCSetClusterPauseRequest{}); err != nil {
+			return nil, err
+		}
+		if wasPaused {
+			rpc.service.logger.Info("Signing resumed on all cosigners")
+		}
+		return &proto.AdminGRPCResumeResponse{WasPaused: wasPaused}, nil
+	}
+	wasPaused := rpc.service.cfg.Pause.Resume()
+	if wasPaused {
+		rpc.service.logger.Info("Signing resumed")
+	}
+	return &proto.AdminGRPCResumeResponse{WasPaused: wasPaused}, nil
+}
+
+// setClusterPause replicates the cluster pause through the raft leader,
+// and waits until it has been applied on this cosigner
+func (rpc *AdminGRPCServer) setClusterPause(ctx context.Context, req *proto.CosignerGRPCSetClusterPauseRequest) error {
+	raftStore := rpc.service.cfg.RaftStore
+	if raftStore == nil {
+		return errLeaderlessMode
+	}
+	auth, err := rpc.service.cfg.Cosigner.signClusterRequest(req)
+	if err != nil {
+		return err
+	}
+	req.Auth = auth
+	client, conn, err := raftStore.getLeaderGRPCClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err := client.SetClusterPause(ctx, req); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		if rpc.service.cfg.Pause.ClusterPause().Paused == req.GetPaused() {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("cluster pause replicated, waiting for it to be applied: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func (rpc *AdminGRPCServer) GetState(
+	ctx context.Context,
+	req *proto.AdminGRPCGetStateRequest,
+) (*proto.AdminGRPCGetStateResponse, error) {
+	cfg := rpc.service.cfg
+	res := &proto.AdminGRPCGetStateResponse{
+		ChainID: cfg.ChainID,
+		PrivValState: adminSignStateWithSignBytes(&cfg.ThresholdValidator.lastSignState,
+			&cfg.ThresholdValidator.lastSignStateMutex),
+		ShareState: adminSignStateWithSignBytes(cfg.Cosigner.lastSignState, &cfg.Cosigner.lastSignStateMutex),
+	}
+	var since time.Time
+	res.Paused, res.PauseReason, since = cfg.Pause.Paused()
+	if res.Paused {
+		res.PausedSince = since.UnixNano()
+	}
+	if cp := cfg.Pause.ClusterPause(); cp.Paused {
+		res.ClusterPaused = true
+		res.ClusterPauseReason = cp.Reason
+		res.ClusterPausedSince = cp.Since.UnixNano()
+		res.ResumeHeight = cp.ResumeHeight
+	}
+	// the chain changes at an upgrade
+	if chainID := cfg.Cosigner.upgrade.ChainID(); chainID != "" {
+		res.ChainID = chainID
+	}
+	if plan := cfg.Cosigner.upgrade.Plan(); plan != nil {
+		res.HaltHeight = plan.HaltHeight
+		res.NewChainID = plan.NewChainID
+		res.StartHeight = plan.StartHeight
+	}
+	return res, nil
+}
+
+// SetState sets the priv validator and share sign states, only while signing is paused
+// so that no signing round is in progress. Like state set, the sign state can not be lowered.
+// With cluster, the sign state is set on all cosigners through the raft leader while the cluster is paused.
+func (rpc *AdminGRPCServer) SetState(
+	ctx context.Context,
+	req *proto.AdminGRPCSetStateRequest,
+) (*proto.AdminGRPCSetStateResponse, error) {
+	cfg := rpc.service.cfg
+	ssc := NewSignStateConsensus(req.GetHeight(), req.GetRound(), int8(req.GetStep()))
+	if req.GetCluster() {
+		if err := rpc.setClusterSignState(ctx, ssc); err != nil {
+			return nil, err
+		}
+	} else {
+		if !cfg.Pause.isPaused() {
+			return nil, status.Error(codes.FailedPrecondition, "signing must be paused to set the sign state")
+		}
+		waitCtx, cancel := context.WithTimeout(ctx, adminSetStateTimeout)
+		defer cancel()
+		if err := cfg.ThresholdValidator.waitForSignBlocks(waitCtx); err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+		if err := setSignStates(cfg.ThresholdValidator, cfg.Cosigner, ssc); err != nil {
+			return nil, err
+		}
+		rpc.service.logger.Info("Sign state set", "height", ssc.Height, "round", ssc.Round, "step", ssc.Step)
+	}
+
+	return &proto.AdminGRPCSetStateResponse{
+		PrivValState: adminSignState(cfg.ThresholdValidator.lastSignedHRS()),
+		ShareState:   adminSignState(cfg.Cosigner.lastSignedHRS()),
+	}, nil
+}
+
+// setClusterSignState replicates the sign state through the raft leader,
+// and waits until it has been applied on this cosigner
+func (rpc *AdminGRPCServer) setClusterSignState(ctx context.Context, ssc SignStateConsensus) error {
+	cfg := rpc.service.cfg
+	if cfg.RaftStore == nil {
+		return errLeaderlessMode
+	}
+	if !cfg.Pause.ClusterPause().Paused {
+		return status.Error(codes.FailedPrecondition,
+			"signing must be paused on all cosigners to set the sign state of the cluster")
+	}
+	req := &proto.CosignerGRPCSetClusterSignStateRequest{
+		Hrst: &proto.HRST{Height: ssc.Height, Round: ssc.Round, Step: int32(ssc.Step)},
+	}
+	auth, err := cfg.Cosigner.signClusterRequest(req)
+	if err != nil {
+		return err
+	}
+	req.Auth = auth
+	client, conn, err := cfg.RaftStore.getLeaderGRPCClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if _, err := client.SetClusterSignState(ctx, req); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		if hrs := cfg.ThresholdValidator.lastSignedHRS(); hrs.Height == ssc.Height && hrs.Round == ssc.Round &&
+			hrs.Step == ssc.Step {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("sign state replicated, waiting for it to be applied: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// setSignStates synchronously saves the priv validator and share sign states
+func setSignStates(tv *ThresholdValidator, cosigner *LocalCosigner, ssc SignStateConsensus) error {
+	if err := setSignState(&tv.lastSignState, &tv.lastSignStateMutex, ssc); err != nil {
+		return fmt.Errorf("error saving privval sign state: %w", err)
+	}
+	// the initiated state is only kept in memory, it may already be ahead
+	_ = tv.SaveLastSignedStateInitiated(ssc)
+	if err := setSignState(cosigner.lastSignState, &cosigner.lastSignStateMutex, ssc); err != nil {
+		return fmt.Errorf("error saving share sign state: %w", err)
+	}
+	return nil
+}
+
+// setSignState synchronously saves the sign state without the signature and sign bytes of the previous one
+func setSignState(signState *SignState, lock *sync.Mutex, ssc SignStateConsensus) error {
+	lock.Lock()
+	defer lock.Unlock()
+	ephemeralPublic := signState.EphemeralPublic
+	signState.EphemeralPublic = nil
+	if err := signState.Save(ssc, nil, false); err != nil {
+		signState.EphemeralPublic = ephemeralPublic
+		return err
+	}
+	return nil
+}
+
+func adminSignState(hrst HRSTKey) *proto.AdminSignState {
+	return &proto.AdminSignState{Height: hrst.Height, Round: hrst.Round, Step: int32(hrst.Step)}
+}
+
+// adminSignStateWithSignBytes returns the sign state with the sign bytes that were signed last
+func adminSignStateWithSignBytes(signState *SignState, lock *sync.Mutex) *proto.AdminSignState {
+	ssc := signState.consensus(lock)
+	return &proto.AdminSignState{
+		Height:    ssc.Height,
+		Round:     ssc.Round,
+		Step:      int32(ssc.Step),
+		SignBytes: ssc.SignBytes,
+	}
+}
+
+func (rpc *AdminGRPCServer) ReloadConfig(
+	ctx context.Context,
+	req *proto.AdminGRPCReloadConfigRequest,
+) (*proto.AdminGRPCReloadConfigResponse, error) {
+	reload := rpc.service.cfg.ReloadConfig
+	if reload == nil {
+		return nil, status.Error(codes.Unimplemented, "config reload is not supported")
+	}
+	changes, err := reload()
+	if err != nil {
+		return nil, err
+	}
+	rpc.service.logger.Info("Reloaded config", "changes", len(changes))
+	return &proto.AdminGRPCReloadConfigResponse{Changes: changes}, nil
+}
+
+func (rpc *AdminGRPCServer) ListPeers(
+	ctx context.Context,
+	req *proto.AdminGRPCListPeersRequest,
+) (*proto.AdminGRPCListPeersResponse, error) {
+	cfg := rpc.service.cfg
+	res := &proto.AdminGRPCListPeersResponse{}
+
+	var peers []Cosigner
+	if cfg.RaftStore == nil {
+		peers = cfg.ThresholdValidator.getPeers()
+	} else {
+		peers = cfg.RaftStore.getPeers()
+		res.Leader = string(cfg.RaftStore.GetLeader())
+	}
+	for _, peer := range peers {
+		res.Peers = append(res.Peers, &proto.AdminPeer{ShareID: int32(peer.GetID()), Address: peer.GetAddress()})
+	}
+
+	if cfg.RemoteSigners != nil {
+		for _, sentry := range cfg.RemoteSigners.Status() {
+			res.Sentries = append(res.Sentries, &proto.AdminSentry{Address: sentry.Address, Connected: sentry.Connected})
+		}
+	}
+	return res, nil
+}
+
+// TransferLeadership asks the raft leader to transfer the leadership, and waits for the new leader
+func (rpc *AdminGRPCServer) TransferLeadership(
+	ctx context.Context,
+	req *proto.AdminGRPCTransferLeadershipRequest,
+) (*proto.AdminGRPCTransferLeadershipResponse, error) {
+	raftStore := rpc.service.cfg.RaftStore
+	if raftStore == nil {
+		return nil, errLeaderlessMode
+	}
+	client, conn, err := raftStore.getLeaderGRPCClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	previous := raftStore.GetLeader()
+	if _, err := client.TransferLeadership(ctx, &proto.CosignerGRPCTransferLeadershipRequest{
+		LeaderID: req.GetLeaderID(),
+	}); err != nil {
+		return nil, err
+	}
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		if leader := raftStore.GetLeader(); leader != "" && leader != previous {
+			return &proto.AdminGRPCTransferLeadershipResponse{Leader: string(leader)}, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("leadership transfer requested, waiting for the new leader: %w", ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// RecordAdminAction replicates an admin action that affects the whole cluster through the raft leader
+func (rpc *AdminGRPCServer) RecordAdminAction(
+	ctx context.Context,
+	req *proto.AdminGRPCRecordAdminActionRequest,
+) (*proto.AdminGRPCRecordAdminActionResponse, error) {
+	raftStore := rpc.service.cfg.RaftStore
+	if raftStore == nil {
+		return nil, errLeaderlessMode
+	}
+	forward := &proto.CosignerGRPCRecordAdminActionRequest{Action: req.GetAction()}
+	auth, err := rpc.service.cfg.Cosigner.signClusterRequest(forward)
+	if err != nil {
+		return nil, err
+	}
+	forward.Auth = auth
+	client, conn, err := raftStore.getLeaderGRPCClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	if _, err := client.RecordAdminAction(ctx, forward); err != nil {
+		return nil, err
+	}
+	return &proto.AdminGRPCRecordAdminActionResponse{}, nil
+}
@@ -0,0 +1,200 @@
+package signer
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	proto "github.com/strangelove-ventures/horcrux/signer/proto"
+	"github.com/stretchr/testify/require"
+	tmCryptoEd25519 "github.com/tendermint/tendermint/crypto/ed25519"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+)
+
+func TestParseAdminAddress(t *testing.T) {
+	tcs := []struct {
+		address string
+		network string
+		addr    string
+		err     bool
+	}{
+		{address: "unix:///var/run/horcrux/admin.sock", network: "unix", addr: "/var/run/horcrux/admin.sock"},
+		{address: "tcp://127.0.0.1:2223", network: "tcp", addr: "127.0.0.1:2223"},
+		{address: "tcp://localhost:2223", network: "tcp", addr: "localhost:2223"},
+		{address: "tcp://[::1]:2223", network: "tcp", addr: "[::1]:2223"},
+		{address: "tcp://0.0.0.0:2223", err: true},
+		{address: "tcp://signer-1:2223", err: true},
+		{address: "tcp://127.0.0.1", err: true},
+		{address: "unix://", err: true},
+		{address: "http://127.0.0.1:2223", err: true},
+	}
+	for _, tc := range tcs {
+		network, addr, err := ParseAdminAddress(tc.address)
+		if tc.err {
+			require.Error(t, err, tc.address)
+			continue
+		}
+		require.NoError(t, err, tc.address)
+		require.Equal(t, tc.network, network)
+		require.Equal(t, tc.addr, addr)
+	}
+}
+
+func TestLoadOrCreateAdminToken(t *testing.T) {
+	tokenFile := filepath.Join(t.TempDir(), "admin_token")
+
+	_, err := LoadAdminToken(tokenFile)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	token, err := LoadOrCreateAdminToken(tokenFile)
+	require.NoError(t, err)
+	require.Len(t, token, 64)
+
+	fi, err := os.Stat(tokenFile)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	loaded, err := LoadOrCreateAdminToken(tokenFile)
+	require.NoError(t, err)
+	require.Equal(t, token, loaded)
+}
+
+func TestAdminService(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	privateKey := tmCryptoEd25519.GenPrivKey()
+	dir := t.TempDir()
+
+	signState, err := LoadOrCreateSignState(filepath.Join(dir, "state.json"))
+	require.NoError(t, err)
+	shareSignState, err := LoadOrCreateSignState(filepath.Join(dir, "share_state.json"))
+	require.NoError(t, err)
+	require.NoError(t, shareSignState.Save(NewSignStateConsensus(5, 1, stepPrecommit), nil, false))
+
+	pause := &SigningPause{}
+	cosigner := NewLocalCosigner(LocalCosignerConfig{
+		CosignerKey: CosignerKey{PubKey: privateKey.PubKey(), ID: 1},
+		SignState:   &shareSignState,
+		RsaKey:      *rsaKey,
+		Peers:       []CosignerPeer{{ID: 1, PublicKey: rsaKey.PublicKey}},
+		Total:       2,
+		Threshold:   2,
+		Pause:       pause,
+	})
+
+	logger := tmlog.NewNopLogger()
+	validator := NewThresholdValidator(&ThresholdValidatorOpt{
+		Pubkey:     privateKey.PubKey(),
+		Threshold:  2,
+		SignState:  signState,
+		Cosigner:   cosigner,
+		Peers:      []Cosigner{NewRemoteCosigner(2, "tcp://127.0.0.1:1")},
+		Leaderless: true,
+		Logger:     logger,
+		Pause:      pause,
+	})
+	require.NoError(t, validator.SaveLastSignedState(NewSignStateConsensus(4, 0, stepPrevote)))
+
+	remoteSigners := NewRemoteSigners(logger, "chain-id", validator, []NodeConfig{{Address: "tcp://127.0.0.1:1"}}, nil)
+
+	socket := filepath.Join(dir, "admin.sock")
+	adminService := NewAdminService(logger, AdminServiceConfig{
+		ListenAddress:      "unix://" + socket,
+		Token:              "secret",
+		ChainID:            "chain-id",
+		Pause:              pause,
+		ThresholdValidator: validator,
+		Cosigner:           cosigner,
+		RemoteSigners:      remoteSigners,
+	})
+	require.NoError(t, adminService.Start())
+	defer adminService.Stop() //nolint:errcheck
+
+	fi, err := os.Stat(socket)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0600), fi.Mode().Perm())
+
+	dial := func(token string) proto.AdminGRPCClient {
+		conn, err := grpc.Dial("unix://"+socket,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithPerRPCCredentials(AdminTokenCredentials(token)))
+		require.NoError(t, err)
+		t.Cleanup(func() { conn.Close() })
+		return proto.NewAdminGRPCClient(conn)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	_, err = dial("wrong").GetState(ctx, &proto.AdminGRPCGetStateRequest{})
+	require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+	client := dial("secret")
+	state, err := client.GetState(ctx, &proto.AdminGRPCGetStateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "chain-id", state.ChainID)
+	require.Equal(t, &proto.AdminSignState{Height: 4, Round: 0, Step: int32(stepPrevote)}, state.PrivValState)
+	require.Equal(t, &proto.AdminSignState{Height: 5, Round: 1, Step: int32(stepPrecommit)}, state.ShareState)
+	require.False(t, state.Paused)
+
+	// the sign state is only set while paused
+	_, err = client.SetState(ctx, &proto.AdminGRPCSetStateRequest{Height: 100})
+	require.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	_, err = client.Pause(ctx, &proto.AdminGRPCPauseRequest{Reason: "maintenance"})
+	require.NoError(t, err)
+	state, err = client.GetState(ctx, &proto.AdminGRPCGetStateRequest{})
+	require.NoError(t, err)
+	require.True(t, state.Paused)
+	require.Equal(t, "maintenance", state.PauseReason)
+
+	_, _, err = validator.SignBlock("chain-id", &Block{Height: 6, Step: stepPrevote, Timestamp: time.Now()})
+	require.IsType(t, &PausedError{}, err)
+	_, err = cosigner.GetEphemeralSecretParts(HRSTKey{Height: 6, Step: stepPrevote})
+	require.IsType(t, &PausedError{}, err)
+
+	res, err := client.SetState(ctx, &proto.AdminGRPCSetStateRequest{Height: 100, Round: 1, Step: int32(stepPrevote)})
+	require.NoError(t, err)
+	require.Equal(t, &proto.AdminSignState{Height: 100, Round: 1, Step: int32(stepPrevote)}, res.PrivValState)
+	require.Equal(t, &proto.AdminSignState{Height: 100, Round: 1, Step: int32(stepPrevote)}, res.ShareState)
+
+	// persisted synchronously
+	saved, err := LoadSignState(filepath.Join(dir, "share_state.json"))
+	require.NoError(t, err)
+	require.Equal(t, int64(100), saved.Height)
+
+	// the height can not be lowered
+	_, err = client.SetState(ctx, &proto.AdminGRPCSetStateRequest{Height: 50})
+	require.Error(t, err)
+
+	resumed, err := client.Resume(ctx, &proto.AdminGRPCResumeRequest{})
+	require.NoError(t, err)
+	require.True(t, resumed.WasPaused)
+
+	peers, err := client.ListPeers(ctx, &proto.AdminGRPCListPeersRequest{})
+	require.NoError(t, err)
+	require.Len(t, peers.Peers, 1)
+	require.Equal(t, int32(2), peers.Peers[0].ShareID)
+	require.Equal(t, []*proto.AdminSentry{{Address: "tcp://127.0.0.1:1"}}, peers.Sentries)
+
+	_, err = client.TransferLeadership(ctx, &proto.AdminGRPCTransferLeadershipRequest{})
+	require.Error(t, err)
+	_, err = client.ReloadConfig(ctx, &proto.AdminGRPCReloadConfigRequest{})
+	require.Equal(t, codes.Unimplemented, status.Code(err))
+
+	// the cluster is paused through raft, not available in leaderless mode
+	_, err = client.Pause(ctx, &proto.AdminGRPCPauseRequest{Cluster: true})
+	require.Error(t, err)
+	_, err = client.Pause(ctx, &proto.AdminGRPCPauseRequest{ResumeHeight: 200})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = client.SetState(ctx, &proto.AdminGRPCSetStateRequest{Height: 200, Cluster: true})
+	require.Error(t, err)
+}
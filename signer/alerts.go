@@ -0,0 +1,354 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	tmLog "github.com/tendermint/tendermint/libs/log"
+)
+
+// AlertEvent is the kind of event an alert is fired for
+type AlertEvent string
+
+const (
+	AlertMissedPrecommits      AlertEvent = "missed-precommits"
+	AlertMissedPrevotes        AlertEvent = "missed-prevotes"
+	AlertRaftLeaderChange      AlertEvent = "raft-leader-change"
+	AlertInsufficientCosigners AlertEvent = "insufficient-cosigners"
+	AlertInvalidSignature      AlertEvent = "invalid-signature"
+	AlertSentryDisconnected    AlertEvent = "sentry-disconnected"
+	AlertConflictingRequest    AlertEvent = "conflicting-request"
+)
+
+// AlertEvents are all the events alerts can be fired for
+var AlertEvents = []AlertEvent{
+	AlertMissedPrecommits,
+	AlertMissedPrevotes,
+	AlertRaftLeaderChange,
+	AlertInsufficientCosigners,
+	AlertInvalidSignature,
+	AlertSentryDisconnected,
+	AlertConflictingRequest,
+}
+
+// AlertSeverity is the severity of an alert, using the PagerDuty severities
+type AlertSeverity string
+
+const (
+	AlertCritical AlertSeverity = "critical"
+	AlertError    AlertSeverity = "error"
+	AlertWarning  AlertSeverity = "warning"
+	AlertInfo     AlertSeverity = "info"
+)
+
+const (
+	// DefaultAlertRateLimit is the default minimum time between two alerts for the same event
+	DefaultAlertRateLimit = 5 * time.Minute
+
+	// DefaultMissedVotesAlertThreshold is the default number of consecutive missed precommits
+	// or prevotes that fires an alert
+	DefaultMissedVotesAlertThreshold = 3
+
+	// timeout of a single notification
+	notifyTimeout = 10 * time.Second
+)
+
+// Alert is a notification about an event detected by horcrux
+type Alert struct {
+	Event    AlertEvent    `json:"event"`
+	Severity AlertSeverity `json:"severity"`
+	Summary  string        `json:"summary"`
+
+	// signer node that fired the alert
+	Source string `json:"source"`
+
+	// alerts for the same event and key are rate limited together, i.e. the sentry address
+	Key string `json:"key,omitempty"`
+
+	Details map[string]string `json:"details,omitempty"`
+	Time    time.Time         `json:"time"`
+
+	// number of alerts for the same event and key suppressed by the rate limit since the last one
+	Suppressed int `json:"suppressed,omitempty"`
+}
+
+// Notifier sends alerts to an external service
+type Notifier interface {
+	Notify(ctx context.Context, alert Alert) error
+}
+
+// Alerter fires alerts to the notifiers, rate limited per event and key
+type Alerter struct {
+	// minimum time between two alerts for the same event and key
+	RateLimit time.Duration
+
+	// number of consecutive missed precommits or prevotes that fires an alert
+	MissedPrecommitsThreshold int64
+	MissedPrevotesThreshold   int64
+
+	// events that fire alerts, all events if nil
+	Events map[AlertEvent]bool
+
+	source    string
+	notifiers []Notifier
+	logger    tmLog.Logger
+
+	mu         sync.Mutex
+	last       map[string]time.Time
+	suppressed map[string]int
+}
+
+// NewAlerter returns a new Alerter with the default rate limit and thresholds
+func NewAlerter(logger tmLog.Logger, source string, notifiers []Notifier) *Alerter {
+	return &Alerter{
+		RateLimit:                 DefaultAlertRateLimit,
+		MissedPrecommitsThreshold: DefaultMissedVotesAlertThreshold,
+		MissedPrevotesThreshold:   DefaultMissedVotesAlertThreshold,
+		source:                    source,
+		notifiers:                 notifiers,
+		logger:                    logger,
+		last:                      make(map[string]time.Time),
+		suppressed:                make(map[string]int),
+	}
+}
+
+// alerter fires the alerts of this process, nil if alerting is not configured
+var (
+	alerter   *Alerter
+	alerterMu sync.RWMutex
+)
+
+// SetAlerter sets the alerter that fires the alerts of this process
+func SetAlerter(a *Alerter) {
+	alerterMu.Lock()
+	defer alerterMu.Unlock()
+	alerter = a
+}
+
+// fireAlert fires the alert if alerting is configured
+func fireAlert(alert Alert) {
+	alerterMu.RLock()
+	a := alerter
+	alerterMu.RUnlock()
+	if a != nil {
+		a.Fire(alert)
+	}
+}
+
+// alertMissedVotes fires an alert if the number of consecutive missed votes reaches the threshold
+func alertMissedVotes(event AlertEvent, missed int64, height int64) {
+	alerterMu.RLock()
+	a := alerter
+	alerterMu.RUnlock()
+	if a == nil {
+		return
+	}
+	threshold, kind := a.MissedPrecommitsThreshold, "precommits"
+	if event == AlertMissedPrevotes {
+		threshold, kind = a.MissedPrevotesThreshold, "prevotes"
+	}
+	if missed < threshold {
+		return
+	}
+	a.Fire(Alert{
+		Event:    event,
+		Severity: AlertError,
+		Summary:  fmt.Sprintf("Missed %d consecutive %s before height %d", missed, kind, height),
+		Details: map[string]string{
+			"missed": fmt.Sprint(missed),
+			"height": fmt.Sprint(height),
+		},
+	})
+}
+
+// Fire sends the alert to all notifiers in the background, unless the event is disabled
+// or an alert for the same event and key was fired within the rate limit.
+func (a *Alerter) Fire(alert Alert) {
+	if a.Events != nil && !a.Events[alert.Event] {
+		return
+	}
+
+	now := time.Now()
+	key := string(alert.Event) + "/" + alert.Key
+
+	a.mu.Lock()
+	if last, ok := a.last[key]; ok && now.Sub(last) < a.RateLimit {
+		a.suppressed[key]++
+		a.mu.Unlock()
+		totalAlertsSuppressed.WithLabelValues(string(alert.Event)).Inc()
+		return
+	}
+	a.last[key] = now
+	alert.Suppressed = a.suppressed[key]
+	delete(a.suppressed, key)
+	a.mu.Unlock()
+
+	alert.Source = a.source
+	if alert.Time.IsZero() {
+		alert.Time = now.UTC()
+	}
+	totalAlertsFired.WithLabelValues(string(alert.Event)).Inc()
+
+	for _, notifier := range a.notifiers {
+		go func(notifier Notifier) {
+			ctx, cancel := context.WithTimeout(context.Background(), notifyTimeout)
+			defer cancel()
+			if err := notifier.Notify(ctx, alert); err != nil {
+				totalAlertNotifyErrors.Inc()
+				a.logger.Error("Failed to send alert", "event", alert.Event, "error", err)
+			}
+		}(notifier)
+	}
+}
+
+// NewNotifier returns the notifier of the given type:
+// webhook for a generic JSON webhook, slack for a Slack incoming webhook,
+// or pagerduty for the PagerDuty Events API v2, which requires a routing key.
+func NewNotifier(notifierType, endpoint, routingKey string) (Notifier, error) {
+	switch notifierType {
+	case "webhook":
+		if endpoint == "" {
+			return nil, errors.New("webhook notifier requires a url")
+		}
+		return &WebhookNotifier{URL: endpoint}, nil
+	case "slack":
+		if endpoint == "" {
+			return nil, errors.New("slack notifier requires a url")
+		}
+		return &SlackNotifier{URL: endpoint}, nil
+	case "pagerduty":
+		if routingKey == "" {
+			return nil, errors.New("pagerduty notifier requires a routing key")
+		}
+		if endpoint == "" {
+			endpoint = DefaultPagerDutyURL
+		}
+		return &PagerDutyNotifier{URL: endpoint, RoutingKey: routingKey}, nil
+	default:
+		return nil, fmt.Errorf("unknown notifier type %q, must be webhook, slack or pagerduty", notifierType)
+	}
+}
+
+// WebhookNotifier posts the alert as JSON to a URL
+type WebhookNotifier struct {
+	URL string
+}
+
+// Notify implements Notifier
+func (n *WebhookNotifier) Notify(ctx context.Context, alert Alert) error {
+	return postJSON(ctx, n.URL, alert)
+}
+
+// SlackNotifier posts the alert to a Slack compatible incoming webhook
+type SlackNotifier struct {
+	URL string
+}
+
+// Notify implements Notifier
+func (n *SlackNotifier) Notify(ctx context.Context, alert Alert) error {
+	var text strings.Builder
+	fmt.Fprintf(&text, "*[%s] %s*\n%s", alert.Severity, alert.Event, alert.Summary)
+	fmt.Fprintf(&text, "\n`source`: %s", alert.Source)
+	for _, key := range sortedKeys(alert.Details) {
+		fmt.Fprintf(&text, "\n`%s`: %s", key, alert.Details[key])
+	}
+	if alert.Suppressed > 0 {
+		fmt.Fprintf(&text, "\n_%d similar alerts suppressed_", alert.Suppressed)
+	}
+	return postJSON(ctx, n.URL, map[string]string{"text": text.String()})
+}
+
+// DefaultPagerDutyURL is the PagerDuty Events API v2 endpoint
+const DefaultPagerDutyURL = "https://events.pagerduty.com/v2/enqueue"
+
+// PagerDutyNotifier triggers a PagerDuty Events API v2 compatible event for the alert
+type PagerDutyNotifier struct {
+	URL        string
+	RoutingKey string
+}
+
+type pagerDutyEvent struct {
+	RoutingKey  string           `json:"routing_key"`
+	EventAction string           `json:"event_action"`
+	DedupKey    string           `json:"dedup_key"`
+	Payload     pagerDutyPayload `json:"payload"`
+}
+
+type pagerDutyPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      AlertSeverity     `json:"severity"`
+	Timestamp     string            `json:"timestamp"`
+	Component     string            `json:"component"`
+	Class         string            `json:"class"`
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+// Notify implements Notifier
+func (n *PagerDutyNotifier) Notify(ctx context.Context, alert Alert) error {
+	dedupKey := fmt.Sprintf("horcrux/%s/%s", alert.Source, alert.Event)
+	if alert.Key != "" {
+		dedupKey += "/" + alert.Key
+	}
+	return postJSON(ctx, n.URL, pagerDutyEvent{
+		RoutingKey:  n.RoutingKey,
+		EventAction: "trigger",
+		DedupKey:    dedupKey,
+		Payload: pagerDutyPayload{
+			Summary:       alert.Summary,
+			Source:        alert.Source,
+			Severity:      alert.Severity,
+			Timestamp:     alert.Time.Format(time.RFC3339),
+			Component:     "horcrux",
+			Class:         string(alert.Event),
+			CustomDetails: alert.Details,
+		},
+	})
+}
+
+// postJSON posts the body as JSON and returns an error if the response status is not 2xx.
+// Errors do not include the URL, since webhook URLs often contain a secret.
+func postJSON(ctx context.Context, endpoint string, body interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return errors.New("invalid notifier url")
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			return urlErr.Err
+		}
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(res.Body, 512))
+		return fmt.Errorf("unexpected status %s: %s", res.Status, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
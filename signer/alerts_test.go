@@ -0,0 +1,190 @@
+package signer
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+)
+
+type recordingNotifier struct {
+	mu     sync.Mutex
+	alerts []Alert
+	sent   chan struct{}
+}
+
+func newRecordingNotifier() *recordingNotifier {
+	return &recordingNotifier{sent: make(chan struct{}, 16)}
+}
+
+func (n *recordingNotifier) Notify(_ context.Context, alert Alert) error {
+	n.mu.Lock()
+	n.alerts = append(n.alerts, alert)
+	n.mu.Unlock()
+	n.sent <- struct{}{}
+	return nil
+}
+
+// wait waits for count notifications and returns all notified alerts
+func (n *recordingNotifier) wait(t *testing.T, count int) []Alert {
+	for i := 0; i < count; i++ {
+		select {
+		case <-n.sent:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for alert")
+		}
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return append([]Alert(nil), n.alerts...)
+}
+
+func TestAlerterRateLimit(t *testing.T) {
+	notifier := newRecordingNotifier()
+	a := NewAlerter(tmlog.NewNopLogger(), "signer-1", []Notifier{notifier})
+
+	a.Fire(Alert{Event: AlertSentryDisconnected, Key: "tcp://sentry-1:1234"})
+	a.Fire(Alert{Event: AlertSentryDisconnected, Key: "tcp://sentry-1:1234"})
+	a.Fire(Alert{Event: AlertSentryDisconnected, Key: "tcp://sentry-1:1234"})
+
+	// different key is not rate limited together
+	a.Fire(Alert{Event: AlertSentryDisconnected, Key: "tcp://sentry-2:1234"})
+
+	alerts := notifier.wait(t, 2)
+	require.Len(t, alerts, 2)
+	for _, alert := range alerts {
+		require.Equal(t, "signer-1", alert.Source)
+		require.False(t, alert.Time.IsZero())
+	}
+
+	// the next alert after the rate limit reports the suppressed ones
+	a.RateLimit = 0
+	a.Fire(Alert{Event: AlertSentryDisconnected, Key: "tcp://sentry-1:1234"})
+	alerts = notifier.wait(t, 1)
+	require.Equal(t, 2, alerts[2].Suppressed)
+}
+
+func TestAlerterEvents(t *testing.T) {
+	notifier := newRecordingNotifier()
+	a := NewAlerter(tmlog.NewNopLogger(), "signer-1", []Notifier{notifier})
+	a.Events = map[AlertEvent]bool{AlertConflictingRequest: true}
+
+	a.Fire(Alert{Event: AlertRaftLeaderChange})
+	a.Fire(Alert{Event: AlertConflictingRequest})
+
+	alerts := notifier.wait(t, 1)
+	require.Len(t, alerts, 1)
+	require.Equal(t, AlertConflictingRequest, alerts[0].Event)
+}
+
+func TestAlertMissedVotes(t *testing.T) {
+	notifier := newRecordingNotifier()
+	a := NewAlerter(tmlog.NewNopLogger(), "signer-1", []Notifier{notifier})
+	a.MissedPrevotesThreshold = 5
+	SetAlerter(a)
+	defer SetAlerter(nil)
+
+	alertMissedVotes(AlertMissedPrevotes, 4, 100)
+	alertMissedVotes(AlertMissedPrecommits, 3, 100)
+	alertMissedVotes(AlertMissedPrevotes, 5, 101)
+
+	alerts := notifier.wait(t, 2)
+	require.Len(t, alerts, 2)
+
+	events := map[AlertEvent]Alert{}
+	for _, alert := range alerts {
+		events[alert.Event] = alert
+	}
+	require.Equal(t, "3", events[AlertMissedPrecommits].Details["missed"])
+	require.Equal(t, "5", events[AlertMissedPrevotes].Details["missed"])
+}
+
+// serveNotifications returns a server recording the JSON bodies posted to it
+func serveNotifications(t *testing.T, status int) (*httptest.Server, chan []byte) {
+	bodies := make(chan []byte, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodies <- body
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(server.Close)
+	return server, bodies
+}
+
+var testAlert = Alert{
+	Event:    AlertConflictingRequest,
+	Severity: AlertCritical,
+	Summary:  "Refused conflicting sign request",
+	Source:   "signer-1",
+	Key:      "tcp://sentry-1:1234",
+	Details:  map[string]string{"height": "10", "sentry": "tcp://sentry-1:1234"},
+	Time:     time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC),
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	server, bodies := serveNotifications(t, http.StatusOK)
+
+	notifier, err := NewNotifier("webhook", server.URL, "")
+	require.NoError(t, err)
+	require.NoError(t, notifier.Notify(context.Background(), testAlert))
+
+	var alert Alert
+	require.NoError(t, json.Unmarshal(<-bodies, &alert))
+	require.Equal(t, testAlert, alert)
+}
+
+func TestSlackNotifier(t *testing.T) {
+	server, bodies := serveNotifications(t, http.StatusOK)
+
+	notifier, err := NewNotifier("slack", server.URL, "")
+	require.NoError(t, err)
+	require.NoError(t, notifier.Notify(context.Background(), testAlert))
+
+	var message map[string]string
+	require.NoError(t, json.Unmarshal(<-bodies, &message))
+	require.Equal(t, "*[critical] conflicting-request*\nRefused conflicting sign request\n"+
+		"`source`: signer-1\n`height`: 10\n`sentry`: tcp://sentry-1:1234", message["text"])
+}
+
+func TestPagerDutyNotifier(t *testing.T) {
+	server, bodies := serveNotifications(t, http.StatusAccepted)
+
+	_, err := NewNotifier("pagerduty", server.URL, "")
+	require.Error(t, err)
+
+	notifier, err := NewNotifier("pagerduty", server.URL, "routing-key")
+	require.NoError(t, err)
+	require.NoError(t, notifier.Notify(context.Background(), testAlert))
+
+	var event pagerDutyEvent
+	require.NoError(t, json.Unmarshal(<-bodies, &event))
+	require.Equal(t, "routing-key", event.RoutingKey)
+	require.Equal(t, "trigger", event.EventAction)
+	require.Equal(t, "horcrux/signer-1/conflicting-request/tcp://sentry-1:1234", event.DedupKey)
+	require.Equal(t, AlertCritical, event.Payload.Severity)
+	require.Equal(t, "2022-01-02T03:04:05Z", event.Payload.Timestamp)
+	require.Equal(t, testAlert.Details, event.Payload.CustomDetails)
+}
+
+func TestNotifierErrorStatus(t *testing.T) {
+	server, _ := serveNotifications(t, http.StatusForbidden)
+
+	notifier, err := NewNotifier("webhook", server.URL+"/secret-token", "")
+	require.NoError(t, err)
+	err = notifier.Notify(context.Background(), testAlert)
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), "secret-token")
+
+	_, err = NewNotifier("email", "", "")
+	require.Error(t, err)
+}
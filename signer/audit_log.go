@@ -0,0 +1,344 @@
+package signer
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	tmLog "github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// AuditRoleValidator is the role of audit records for combined signatures of the validator
+	AuditRoleValidator = "validator"
+
+	// AuditRoleCosigner is the role of audit records for signatures with the key share of the cosigner
+	AuditRoleCosigner = "cosigner"
+)
+
+// AuditRecord is a signature in the audit log. Each record holds the hash of the previous one,
+// so that records cannot be changed, removed or reordered without breaking the chain.
+// The hashes are HMACs keyed with the audit key, which is kept outside the state directory,
+// so that the chain cannot be recomputed after changing the log without the key.
+type AuditRecord struct {
+	Seq  uint64    `json:"seq"`
+	Time time.Time `json:"time"`
+	Role string    `json:"role"`
+
+	ChainID string `json:"chain_id,omitempty"`
+	Type    string `json:"type,omitempty"`
+	Height  int64  `json:"height"`
+	Round   int64  `json:"round"`
+	Step    int8   `json:"step"`
+
+	SignBytesHash string `json:"sign_bytes_hash"`
+	BlockID       string `json:"block_id,omitempty"`
+	PartSetHeader string `json:"part_set_header,omitempty"`
+
+	// share IDs of the cosigners that took part in the signature
+	Cosigners []int `json:"cosigners"`
+
+	// sentry that requested the signature, or the cosigner that forwarded the request, if known
+	Source string `json:"source,omitempty"`
+
+	LatencySeconds float64 `json:"latency_seconds"`
+
+	PrevHash string `json:"prev_hash"`
+	Hash     string `json:"hash"`
+}
+
+// computeHash returns the HMAC-SHA256 of the record with the audit key,
+// which covers all fields except the hash itself
+func (r AuditRecord) computeHash(key []byte) (string, error) {
+	r.Hash = ""
+	bz, err := json.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(bz)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// LoadOrCreateAuditKey reads the key of the audit log hashes,
+// generating a random one readable only by the owner if the file does not exist.
+func LoadOrCreateAuditKey(filePath string) ([]byte, error) {
+	key, err := LoadAuditKey(filePath)
+	if err == nil || !errors.Is(err, os.ErrNotExist) {
+		return key, err
+	}
+	key = make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	if err := os.WriteFile(filePath, []byte(hex.EncodeToString(key)+"\n"), 0600); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// LoadAuditKey reads the key of the audit log hashes
+func LoadAuditKey(filePath string) ([]byte, error) {
+	bz, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading audit key: %w", err)
+	}
+	key, err := hex.DecodeString(strings.TrimSpace(string(bz)))
+	if err != nil {
+		return nil, fmt.Errorf("audit key file %s is invalid: %w", filePath, err)
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("audit key file %s is empty", filePath)
+	}
+	return key, nil
+}
+
+// newAuditRecord returns a record for the signature of the sign bytes, without sequence and hashes
+func newAuditRecord(role string, signBytes []byte, cosigners []int, source string, latency time.Duration) AuditRecord {
+	sum := sha256.Sum256(signBytes)
+	r := AuditRecord{
+		Time:           time.Now().UTC(),
+		Role:           role,
+		SignBytesHash:  hex.EncodeToString(sum[:]),
+		Cosigners:      cosigners,
+		Source:         source,
+		LatencySeconds: latency.Seconds(),
+	}
+	content, err := DecodeSignBytes(signBytes)
+	if err != nil {
+		if hrst, err := UnpackHRST(signBytes); err == nil {
+			r.Height, r.Round, r.Step = hrst.Height, hrst.Round, hrst.Step
+		}
+		return r
+	}
+	r.ChainID = content.ChainID
+	r.Type = content.Type.String()
+	r.Height, r.Round, r.Step = content.HRST.Height, content.HRST.Round, content.HRST.Step
+	if content.BlockID != nil {
+		r.BlockID = fmt.Sprintf("%X", content.BlockID.Hash)
+		r.PartSetHeader = fmt.Sprintf("%d:%X", content.BlockID.PartSetHeader.Total, content.BlockID.PartSetHeader.Hash)
+	}
+	return r
+}
+
+// AuditLogError is returned when the audit log is corrupted or has been tampered with
+type AuditLogError struct {
+	msg string
+}
+
+func (e *AuditLogError) Error() string { return e.msg }
+
+func newAuditLogError(line int, format string, args ...interface{}) *AuditLogError {
+	return &AuditLogError{msg: fmt.Sprintf("audit log line %d: ", line) + fmt.Sprintf(format, args...)}
+}
+
+// AuditLog is an append-only, hash-chained log of the signatures of this signer node
+type AuditLog struct {
+	filePath string
+	logger   tmLog.Logger
+	key      []byte
+
+	mu       sync.Mutex
+	file     *os.File
+	seq      uint64
+	lastHash string
+}
+
+// OpenAuditLog opens the audit log at the file path for appending, creating it if it does not exist,
+// with the key of the hashes. Returns an AuditLogError if the last record cannot be read.
+// The chain of the existing records is verified, a broken chain is logged as an error.
+func OpenAuditLog(logger tmLog.Logger, filePath string, key []byte) (*AuditLog, error) {
+	file, err := os.OpenFile(filePath, os.O_RDWR|os.O_CREATE|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	l := &AuditLog{filePath: filePath, logger: logger, key: key, file: file}
+	last, err := readLastLine(file)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if len(last) > 0 {
+		var record AuditRecord
+		if err := json.Unmarshal(last, &record); err != nil {
+			file.Close()
+			return nil, &AuditLogError{msg: fmt.Sprintf("last record of %s is corrupted: %v", filePath, err)}
+		}
+		l.seq, l.lastHash = record.Seq, record.Hash
+	}
+
+	// new records are chained to the last one anyway, so that the log can be verified up to the break
+	if _, count, err := VerifyAuditLog(filePath, key); err != nil {
+		logger.Error("Audit log is not intact, check it with horcrux audit verify and move it aside to start a new one",
+			"file", filePath, "intact_records", count, "error", err)
+	}
+	return l, nil
+}
+
+// readLastLine returns the last non-empty line of the file, reading backwards from the end
+func readLastLine(f *os.File) ([]byte, error) {
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+	const chunkSize = 4096
+	var tail []byte
+	for end := info.Size(); end > 0; {
+		start := end - chunkSize
+		if start < 0 {
+			start = 0
+		}
+		chunk := make([]byte, end-start)
+		if _, err := f.ReadAt(chunk, start); err != nil && err != io.EOF {
+			return nil, err
+		}
+		tail = append(chunk, tail...)
+		trimmed := bytes.TrimRight(tail, "\n")
+		if i := bytes.LastIndexByte(trimmed, '\n'); i >= 0 {
+			return trimmed[i+1:], nil
+		}
+		end = start
+	}
+	return bytes.TrimRight(tail, "\n"), nil
+}
+
+// Append chains the record to the last one and writes it to the log
+func (l *AuditLog) Append(record AuditRecord) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	record.Seq = l.seq + 1
+	record.PrevHash = l.lastHash
+	hash, err := record.computeHash(l.key)
+	if err != nil {
+		return err
+	}
+	record.Hash = hash
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if _, err := l.file.Write(append(line, '\n')); err != nil {
+		return err
+	}
+	if err := l.file.Sync(); err != nil {
+		return err
+	}
+	l.seq, l.lastHash = record.Seq, record.Hash
+	return nil
+}
+
+// Close closes the audit log file
+func (l *AuditLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
+
+// recordSignature appends a record for the signature to the audit log, if any.
+// Failures are logged and do not fail the signature.
+func (l *AuditLog) recordSignature(
+	role string,
+	signBytes []byte,
+	cosigners []int,
+	source string,
+	latency time.Duration,
+) {
+	if l == nil {
+		return
+	}
+	sort.Ints(cosigners)
+	if err := l.Append(newAuditRecord(role, signBytes, cosigners, source, latency)); err != nil {
+		totalAuditLogErrors.Inc()
+		l.logger.Error("Failed to write signature to audit log", "file", l.filePath, "error", err)
+	}
+}
+
+// scanAuditLog calls fn for each record in the audit log file with its line number
+func scanAuditLog(filePath string, fn func(line int, record AuditRecord) error) error {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var record AuditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			return newAuditLogError(line, "invalid record: %v", err)
+		}
+		if err := fn(line, record); err != nil {
+			return err
+		}
+	}
+	return scanner.Err()
+}
+
+// VerifyAuditLog checks that every record of the audit log file is chained to the previous one
+// and has not been changed, with the key of the hashes.
+// Returns the last record, or an AuditLogError for the first broken record.
+func VerifyAuditLog(filePath string, key []byte) (last AuditRecord, count int, err error) {
+	var prev *AuditRecord
+	err = scanAuditLog(filePath, func(line int, record AuditRecord) error {
+		hash, err := record.computeHash(key)
+		if err != nil {
+			return err
+		}
+		if hash != record.Hash {
+			return newAuditLogError(line, "hash of record %d does not match its content", record.Seq)
+		}
+		if prev == nil {
+			if record.Seq != 1 || record.PrevHash != "" {
+				return newAuditLogError(line, "log starts with record %d instead of the first one", record.Seq)
+			}
+		} else {
+			if record.Seq != prev.Seq+1 {
+				return newAuditLogError(line, "record %d follows record %d", record.Seq, prev.Seq)
+			}
+			if record.PrevHash != prev.Hash {
+				return newAuditLogError(line, "record %d is not chained to record %d", record.Seq, prev.Seq)
+			}
+		}
+		prev = &record
+		count++
+		return nil
+	})
+	if err != nil {
+		return AuditRecord{}, count, err
+	}
+	if prev != nil {
+		last = *prev
+	}
+	return last, count, nil
+}
+
+// ReadAuditLog returns the records of the audit log file with a height between minHeight and maxHeight,
+// inclusive. A maxHeight of zero has no upper bound.
+func ReadAuditLog(filePath string, minHeight, maxHeight int64) ([]AuditRecord, error) {
+	var records []AuditRecord
+	err := scanAuditLog(filePath, func(_ int, record AuditRecord) error {
+		if record.Height >= minHeight && (maxHeight == 0 || record.Height <= maxHeight) {
+			records = append(records, record)
+		}
+		return nil
+	})
+	return records, err
+}
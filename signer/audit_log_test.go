@@ -0,0 +1,125 @@
+package signer
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+)
+
+var testAuditKey = []byte("01234567890123456789012345678901")
+
+func writeAuditLog(t *testing.T, filePath string, heights ...int64) {
+	auditLog, err := OpenAuditLog(tmlog.NewNopLogger(), filePath, testAuditKey)
+	require.NoError(t, err)
+	for _, height := range heights {
+		signBytes := precommitSignBytes(height, 0, []byte("01234567890123456789012345678901"), time.Now())
+		auditLog.recordSignature(AuditRoleValidator, signBytes, []int{3, 1}, "tcp://sentry-1:1234", time.Millisecond)
+	}
+	require.NoError(t, auditLog.Close())
+}
+
+func TestAuditLog(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "audit_log.jsonl")
+	writeAuditLog(t, filePath, 10, 11)
+
+	// reopening continues the chain
+	writeAuditLog(t, filePath, 12)
+
+	last, count, err := VerifyAuditLog(filePath, testAuditKey)
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+	require.Equal(t, uint64(3), last.Seq)
+
+	// the hashes are keyed
+	_, _, err = VerifyAuditLog(filePath, []byte("another key"))
+	require.IsType(t, &AuditLogError{}, err)
+
+	records, err := ReadAuditLog(filePath, 11, 0)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, records[0].Hash, records[1].PrevHash)
+
+	record := records[0]
+	require.Equal(t, int64(11), record.Height)
+	require.Equal(t, stepPrecommit, record.Step)
+	require.Equal(t, "SIGNED_MSG_TYPE_PRECOMMIT", record.Type)
+	require.Equal(t, "chain-id", record.ChainID)
+	require.Equal(t, "3031323334353637383930313233343536373839303132333435363738393031", record.BlockID)
+	require.Equal(t, []int{1, 3}, record.Cosigners)
+	require.Equal(t, "tcp://sentry-1:1234", record.Source)
+
+	records, err = ReadAuditLog(filePath, 10, 10)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+}
+
+func TestVerifyAuditLogTampered(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "audit_log.jsonl")
+	writeAuditLog(t, filePath, 10, 11, 12)
+
+	bz, err := os.ReadFile(filePath)
+	require.NoError(t, err)
+	lines := strings.SplitAfter(string(bz), "\n")
+
+	// a changed record with a recomputed chain, which needs the key
+	var record AuditRecord
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &record))
+	record.Height = 13
+	record.Hash, err = record.computeHash([]byte("guessed key"))
+	require.NoError(t, err)
+	rehashed, err := json.Marshal(record)
+	require.NoError(t, err)
+
+	tcs := []struct {
+		name     string
+		contents string
+	}{
+		{name: "changed record", contents: lines[0] + strings.Replace(lines[1], `"height":11`, `"height":13`, 1) + lines[2]},
+		{name: "rehashed record", contents: lines[0] + string(rehashed) + "\n"},
+		{name: "removed record", contents: lines[0] + lines[2]},
+		{name: "reordered records", contents: lines[0] + lines[2] + lines[1]},
+		{name: "removed first record", contents: lines[1] + lines[2]},
+		{name: "truncated record", contents: lines[0] + lines[1][:40]},
+	}
+	for _, tc := range tcs {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, os.WriteFile(filePath, []byte(tc.contents), 0600))
+			_, _, err := VerifyAuditLog(filePath, testAuditKey)
+			require.IsType(t, &AuditLogError{}, err)
+		})
+	}
+
+	// a truncated last record is not silently continued
+	_, err = OpenAuditLog(tmlog.NewNopLogger(), filePath, testAuditKey)
+	require.IsType(t, &AuditLogError{}, err)
+
+	// a broken chain is logged, new records are chained to the last one
+	require.NoError(t, os.WriteFile(filePath, []byte(lines[0]+lines[2]), 0600))
+	writeAuditLog(t, filePath, 13)
+	records, err := ReadAuditLog(filePath, 13, 13)
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	require.Equal(t, uint64(4), records[0].Seq)
+}
+
+func TestLoadOrCreateAuditKey(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "audit_key")
+	key, err := LoadOrCreateAuditKey(filePath)
+	require.NoError(t, err)
+	require.Len(t, key, 32)
+
+	loaded, err := LoadOrCreateAuditKey(filePath)
+	require.NoError(t, err)
+	require.Equal(t, key, loaded)
+
+	require.NoError(t, os.WriteFile(filePath, []byte("not hex\n"), 0600))
+	_, err = LoadAuditKey(filePath)
+	require.Error(t, err)
+}
@@ -0,0 +1,189 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/tendermint/tendermint/crypto"
+	tmLog "github.com/tendermint/tendermint/libs/log"
+)
+
+// verifySignStateConsensus checks that the sign bytes are for the height, round and step
+// of the sign state and that the signature is a valid signature of the validator.
+func verifySignStateConsensus(pubKey crypto.PubKey, ssc SignStateConsensus) error {
+	if len(ssc.SignBytes) == 0 || len(ssc.Signature) == 0 {
+		return errors.New("no sign bytes or signature")
+	}
+	hrst, err := UnpackHRST(ssc.SignBytes)
+	if err != nil {
+		return err
+	}
+	if hrst.Height != ssc.Height || hrst.Round != ssc.Round || hrst.Step != ssc.Step {
+		return fmt.Errorf("sign bytes are for %d/%d/%d, not %d/%d/%d",
+			hrst.Height, hrst.Round, hrst.Step, ssc.Height, ssc.Round, ssc.Step)
+	}
+	if !pubKey.VerifySignature(ssc.SignBytes, ssc.Signature) {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
+
+// SignStateCatchUpError is returned when fewer than a quorum of peers answered with their last sign state
+type SignStateCatchUpError struct {
+	msg string
+}
+
+func (e *SignStateCatchUpError) Error() string { return e.msg }
+
+func newSignStateCatchUpError(answered, quorum int) *SignStateCatchUpError {
+	return &SignStateCatchUpError{
+		msg: fmt.Sprintf("only %d peers answered with their last sign state, %d are needed", answered, quorum),
+	}
+}
+
+// CatchUpSignState queries the peers for the last sign state of the validator and moves the
+// sign state and the share sign state forward to the highest one with a valid signature.
+// Must be called before the cosigner takes part in signing.
+// Returns a SignStateCatchUpError if fewer than quorum peers answered, after catching up with the ones that did.
+func CatchUpSignState(
+	logger tmLog.Logger,
+	pubKey crypto.PubKey,
+	peers []*RemoteCosigner,
+	quorum int,
+	signState *SignState,
+	shareSignState *SignState,
+) error {
+	return catchUpSignState(logger, pubKey, peers, quorum, signState, nil, shareSignState, nil)
+}
+
+// catchUpSignState is CatchUpSignState for sign states that may be in use, guarded by their locks
+func catchUpSignState(
+	logger tmLog.Logger,
+	pubKey crypto.PubKey,
+	peers []*RemoteCosigner,
+	quorum int,
+	signState *SignState,
+	signStateLock *sync.Mutex,
+	shareSignState *SignState,
+	shareSignStateLock *sync.Mutex,
+) error {
+	type lastSignState struct {
+		id  int
+		ssc SignStateConsensus
+		err error
+	}
+	results := make([]lastSignState, len(peers))
+	var wg sync.WaitGroup
+	for i, peer := range peers {
+		wg.Add(1)
+		go func(i int, peer *RemoteCosigner) {
+			defer wg.Done()
+			ssc, err := peer.GetLastSignState()
+			results[i] = lastSignState{id: peer.GetID(), ssc: ssc, err: err}
+		}(i, peer)
+	}
+	wg.Wait()
+
+	answered := 0
+	var highest *SignStateConsensus
+	var highestHRS HRSTKey
+	for _, result := range results {
+		if result.err != nil {
+			logger.Error("Failed to get last sign state from peer", "id", result.id, "error", result.err)
+			continue
+		}
+		answered++
+
+		ssc := result.ssc
+		hrs := HRSTKey{Height: ssc.Height, Round: ssc.Round, Step: ssc.Step}
+		if highest != nil && !highestHRS.Less(hrs) {
+			continue
+		}
+		if signState.GetErrorIfLessOrEqual(ssc.Height, ssc.Round, ssc.Step, signStateLock) != nil &&
+			shareSignState.GetErrorIfLessOrEqual(ssc.Height, ssc.Round, ssc.Step, shareSignStateLock) != nil {
+			// not ahead of us
+			continue
+		}
+		if err := verifySignStateConsensus(pubKey, ssc); err != nil {
+			logger.Error("Ignoring last sign state from peer", "id", result.id,
+				"height", ssc.Height, "round", ssc.Round, "step", ssc.Step, "error", err)
+			continue
+		}
+		highest, highestHRS = &ssc, hrs
+	}
+
+	if highest != nil {
+		logger.Info("Catching up sign state from peers",
+			"height", highest.Height, "round", highest.Round, "step", highest.Step)
+		if signState.GetErrorIfLessOrEqual(highest.Height, highest.Round, highest.Step, signStateLock) == nil {
+			if err := signState.Save(*highest, signStateLock, false); err != nil {
+				return err
+			}
+		}
+		// the share signature for this HRS is unknown, so the watermark is moved without sign bytes,
+		// which prevents the cosigner from signing this HRS again
+		if shareSignState.GetErrorIfLessOrEqual(highest.Height, highest.Round, highest.Step, shareSignStateLock) == nil {
+			ssc := NewSignStateConsensus(highest.Height, highest.Round, highest.Step)
+			if err := shareSignState.Save(ssc, shareSignStateLock, false); err != nil {
+				return err
+			}
+		}
+	}
+
+	if answered < quorum {
+		return newSignStateCatchUpError(answered, quorum)
+	}
+	return nil
+}
+
+// signStateCatchUp gates the readiness of a cosigner whose sign state could not be caught up
+// with a quorum of peers on startup. The catch-up is retried until it succeeds,
+// the peers answer once their gRPC services have been started as well.
+type signStateCatchUp struct {
+	Readiness
+
+	logger    tmLog.Logger
+	peers     []*RemoteCosigner
+	quorum    int
+	validator *ThresholdValidator
+	cosigner  *LocalCosigner
+
+	caughtUp bool
+}
+
+// NewSignStateCatchUpReadiness returns the readiness, which is only ready once the sign states of the
+// threshold validator and the cosigner have caught up with a quorum of peers.
+func NewSignStateCatchUpReadiness(
+	logger tmLog.Logger,
+	readiness Readiness,
+	peers []*RemoteCosigner,
+	quorum int,
+	validator *ThresholdValidator,
+	cosigner *LocalCosigner,
+) Readiness {
+	return &signStateCatchUp{
+		Readiness: readiness,
+		logger:    logger,
+		peers:     peers,
+		quorum:    quorum,
+		validator: validator,
+		cosigner:  cosigner,
+	}
+}
+
+// Ready returns nil once the sign state has caught up and the gated readiness is ready.
+// Implements Readiness interface
+func (c *signStateCatchUp) Ready() error {
+	if !c.caughtUp {
+		err := catchUpSignState(c.logger, c.validator.pubkey, c.peers, c.quorum,
+			&c.validator.lastSignState, &c.validator.lastSignStateMutex,
+			c.cosigner.lastSignState, &c.cosigner.lastSignStateMutex)
+		if err != nil {
+			return err
+		}
+		c.logger.Info("Caught up sign state with a quorum of peers")
+		c.caughtUp = true
+	}
+	return c.Readiness.Ready()
+}
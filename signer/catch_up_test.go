@@ -0,0 +1,138 @@
+package signer
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	proto "github.com/strangelove-ventures/horcrux/signer/proto"
+	"github.com/stretchr/testify/require"
+	tmCryptoEd25519 "github.com/tendermint/tendermint/crypto/ed25519"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	tmProto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tm "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc"
+)
+
+func signedPrecommit(t *testing.T, privateKey tmCryptoEd25519.PrivKey, height int64, round int32) SignStateConsensus {
+	vote := tmProto.Vote{Height: height, Round: round, Type: tmProto.PrecommitType}
+	signBytes := tm.VoteSignBytes("chain-id", &vote)
+	signature, err := privateKey.Sign(signBytes)
+	require.NoError(t, err)
+	return SignStateConsensus{
+		Height:    height,
+		Round:     int64(round),
+		Step:      stepPrecommit,
+		SignBytes: signBytes,
+		Signature: signature,
+	}
+}
+
+// serveLastSignState starts a gRPC server that answers with the given last sign state
+func serveLastSignState(t *testing.T, ssc SignStateConsensus) string {
+	sock, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	signState := SignState{store: noopSignStateStore{}, cache: make(map[HRSKey]SignStateConsensus)}
+	require.NoError(t, signState.Save(ssc, nil, false))
+
+	server := grpc.NewServer()
+	proto.RegisterCosignerGRPCServer(server, &GRPCServer{
+		cosigner:           &LocalCosigner{},
+		thresholdValidator: &ThresholdValidator{lastSignState: signState},
+	})
+	go func() { _ = server.Serve(sock) }()
+	t.Cleanup(server.Stop)
+
+	return fmt.Sprintf("tcp://%s", sock.Addr().String())
+}
+
+func TestVerifySignStateConsensus(t *testing.T) {
+	privateKey := tmCryptoEd25519.GenPrivKey()
+	ssc := signedPrecommit(t, privateKey, 10, 1)
+	require.NoError(t, verifySignStateConsensus(privateKey.PubKey(), ssc))
+
+	wrongHRS := ssc
+	wrongHRS.Height = 11
+	require.EqualError(t, verifySignStateConsensus(privateKey.PubKey(), wrongHRS),
+		"sign bytes are for 10/1/3, not 11/1/3")
+
+	require.EqualError(t, verifySignStateConsensus(tmCryptoEd25519.GenPrivKey().PubKey(), ssc), "invalid signature")
+
+	require.EqualError(t, verifySignStateConsensus(privateKey.PubKey(), NewSignStateConsensus(10, 1, stepPrecommit)),
+		"no sign bytes or signature")
+}
+
+func TestCatchUpSignState(t *testing.T) {
+	privateKey := tmCryptoEd25519.GenPrivKey()
+
+	stateFile := testSignStateFile(t, "state.json")
+	signState, err := LoadOrCreateSignState(stateFile)
+	require.NoError(t, err)
+
+	shareStateFile := testSignStateFile(t, "share_state.json")
+	shareSignState, err := LoadOrCreateSignState(shareStateFile)
+	require.NoError(t, err)
+	require.NoError(t, shareSignState.Save(NewSignStateConsensus(5, 0, stepPrevote), nil, false))
+
+	forged := signedPrecommit(t, tmCryptoEd25519.GenPrivKey(), 20, 0)
+	peers := []*RemoteCosigner{
+		NewRemoteCosigner(2, serveLastSignState(t, signedPrecommit(t, privateKey, 10, 1))),
+		NewRemoteCosigner(3, serveLastSignState(t, signedPrecommit(t, privateKey, 9, 0))),
+		NewRemoteCosigner(4, serveLastSignState(t, forged)),
+		// nothing is listening on the peer address
+		NewRemoteCosigner(5, "tcp://127.0.0.1:1"),
+	}
+
+	err = CatchUpSignState(tmlog.NewNopLogger(), privateKey.PubKey(), peers, 4, &signState, &shareSignState)
+	require.EqualError(t, err, "only 3 peers answered with their last sign state, 4 are needed")
+
+	// the forged sign state is ignored, the highest valid one is used
+	require.Equal(t, int64(10), signState.Height)
+	require.Equal(t, int64(1), signState.Round)
+	require.Equal(t, stepPrecommit, signState.Step)
+	require.NotEmpty(t, signState.Signature)
+
+	require.Equal(t, int64(10), shareSignState.Height)
+	require.Equal(t, int64(1), shareSignState.Round)
+	require.Equal(t, stepPrecommit, shareSignState.Step)
+	require.Empty(t, shareSignState.SignBytes)
+
+	loaded, err := LoadSignState(shareStateFile)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), loaded.Height)
+
+	require.NoError(t,
+		CatchUpSignState(tmlog.NewNopLogger(), privateKey.PubKey(), peers[:3], 3, &signState, &shareSignState))
+}
+
+type readyFunc func() error
+
+func (f readyFunc) Ready() error { return f() }
+
+func TestSignStateCatchUpReadiness(t *testing.T) {
+	privateKey := tmCryptoEd25519.GenPrivKey()
+	newSignState := func() SignState {
+		return SignState{store: noopSignStateStore{}, cache: make(map[HRSKey]SignStateConsensus)}
+	}
+	validator := &ThresholdValidator{pubkey: privateKey.PubKey(), lastSignState: newSignState()}
+	shareSignState := newSignState()
+	cosigner := &LocalCosigner{lastSignState: &shareSignState}
+
+	peers := []*RemoteCosigner{
+		NewRemoteCosigner(2, serveLastSignState(t, signedPrecommit(t, privateKey, 10, 1))),
+		// nothing is listening on the peer address
+		NewRemoteCosigner(3, "tcp://127.0.0.1:1"),
+	}
+	readiness := NewSignStateCatchUpReadiness(tmlog.NewNopLogger(),
+		readyFunc(func() error { return nil }), peers, 2, validator, cosigner)
+
+	// not ready until a quorum of peers answered
+	var catchUpErr *SignStateCatchUpError
+	require.ErrorAs(t, readiness.Ready(), &catchUpErr)
+	require.Equal(t, int64(10), validator.lastSignState.Height)
+	require.Equal(t, int64(10), shareSignState.Height)
+
+	peers[1] = NewRemoteCosigner(3, serveLastSignState(t, signedPrecommit(t, privateKey, 9, 0)))
+	require.NoError(t, readiness.Ready())
+}
@@ -0,0 +1,175 @@
+package signer
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+// UpgradePlan is a coordinated upgrade of the chain: nothing above HaltHeight is signed for ChainID,
+// then all cosigners switch to NewChainID with a sign state starting at StartHeight.
+type UpgradePlan struct {
+	ChainID     string `json:"chain_id"`
+	HaltHeight  int64  `json:"halt_height"`
+	NewChainID  string `json:"new_chain_id"`
+	StartHeight int64  `json:"start_height"`
+}
+
+// HaltHeightError is returned for sign requests above the halt height of an upgrade plan
+type HaltHeightError struct {
+	msg string
+}
+
+func (e *HaltHeightError) Error() string { return e.msg }
+
+func newHaltHeightError(plan UpgradePlan, height int64) *HaltHeightError {
+	return &HaltHeightError{
+		msg: fmt.Sprintf("%s halts at height %d for the upgrade to %s, refusing to sign height %d",
+			plan.ChainID, plan.HaltHeight, plan.NewChainID, height),
+	}
+}
+
+// ChainUpgrade holds the chain ID that is signed for, which changes when the upgrade plan is applied.
+// The threshold validator, the local cosigner and the remote signers of a process share the same ChainUpgrade.
+type ChainUpgrade struct {
+	mu      sync.RWMutex
+	chainID string
+	plan    *UpgradePlan
+
+	// PrivValStateFile and ShareStateFile return the sign state files of a chain
+	PrivValStateFile func(chainID string) string
+	ShareStateFile   func(chainID string) string
+
+	// OnUpgraded is called after switching to the new chain, may be nil
+	OnUpgraded func(plan UpgradePlan)
+}
+
+// NewChainUpgrade returns a ChainUpgrade signing for chainID, plan may be nil
+func NewChainUpgrade(chainID string, plan *UpgradePlan) *ChainUpgrade {
+	return &ChainUpgrade{chainID: chainID, plan: plan}
+}
+
+// ChainID returns the chain ID that is signed for. Returns an empty string for a nil ChainUpgrade.
+func (u *ChainUpgrade) ChainID() string {
+	if u == nil {
+		return ""
+	}
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	return u.chainID
+}
+
+// Plan returns the upgrade plan that has not been applied yet, nil if there is none
+func (u *ChainUpgrade) Plan() *UpgradePlan {
+	if u == nil {
+		return nil
+	}
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	return u.plan
+}
+
+// SetPlan replaces the upgrade plan, i.e. when the config is reloaded. The plan must be for the current chain.
+func (u *ChainUpgrade) SetPlan(plan *UpgradePlan) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if plan != nil && plan.ChainID != u.chainID {
+		return fmt.Errorf("upgrade plan is for chain %s, signing for %s", plan.ChainID, u.chainID)
+	}
+	u.plan = plan
+	return nil
+}
+
+// check returns a ChainIDMismatchError if the chain is not the one signed for, i.e. after an upgrade,
+// or a HaltHeightError if the height is above the halt height of the plan. A nil ChainUpgrade never refuses.
+func (u *ChainUpgrade) check(chainID string, height int64) error {
+	if u == nil {
+		return nil
+	}
+	u.mu.RLock()
+	defer u.mu.RUnlock()
+	if chainID != u.chainID {
+		return newChainIDMismatchError(u.chainID, chainID)
+	}
+	if u.plan == nil || height <= u.plan.HaltHeight {
+		return nil
+	}
+	return newHaltHeightError(*u.plan, height)
+}
+
+// switchTo changes the chain ID to the new chain of the plan.
+// Returns false if the chain is not the old chain of the plan, i.e. when raft replays an applied upgrade.
+func (u *ChainUpgrade) switchTo(plan UpgradePlan) bool {
+	if u == nil {
+		return false
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.chainID != plan.ChainID {
+		return false
+	}
+	u.chainID = plan.NewChainID
+	u.plan = nil
+	return true
+}
+
+// apply switches the threshold validator and the local cosigner to the sign states of the new chain
+func (u *ChainUpgrade) apply(plan UpgradePlan, pv *ThresholdValidator, cosigner *LocalCosigner) error {
+	if !u.switchTo(plan) {
+		return nil
+	}
+	if pv != nil {
+		if err := pv.switchChain(u.PrivValStateFile(plan.NewChainID), plan.StartHeight); err != nil {
+			return fmt.Errorf("error switching privval sign state: %w", err)
+		}
+	}
+	if cosigner != nil {
+		if err := cosigner.switchChain(u.ShareStateFile(plan.NewChainID), plan.StartHeight); err != nil {
+			return fmt.Errorf("error switching share sign state: %w", err)
+		}
+	}
+	if u.OnUpgraded != nil {
+		u.OnUpgraded(plan)
+	}
+	return nil
+}
+
+// switchChain moves the sign state to the file of a new chain, starting at the height.
+// A sign state of the new chain that is already at or above the height is kept.
+func (signState *SignState) switchChain(filePath string, height int64, lock *sync.Mutex) error {
+	next, err := LoadSignState(filePath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err != nil {
+		store, err := openSignStateStore(filePath)
+		if err != nil {
+			return err
+		}
+		next = SignState{cache: make(map[HRSKey]SignStateConsensus), store: store}
+	}
+	if next.Height < height {
+		next = SignState{Height: height, cache: make(map[HRSKey]SignStateConsensus), store: next.store}
+	}
+
+	if lock != nil {
+		lock.Lock()
+		defer lock.Unlock()
+	}
+	previous := signState.store
+	signState.Height = next.Height
+	signState.Round = next.Round
+	signState.Step = next.Step
+	signState.EphemeralPublic = next.EphemeralPublic
+	signState.Signature = next.Signature
+	signState.SignBytes = next.SignBytes
+	signState.cache = next.cache
+	signState.store = next.store
+	signState.save()
+
+	// nothing is signed for the previous chain anymore
+	if previous != nil {
+		_ = previous.Close()
+	}
+	return nil
+}
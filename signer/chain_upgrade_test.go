@@ -0,0 +1,138 @@
+package signer
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmCryptoEd25519 "github.com/tendermint/tendermint/crypto/ed25519"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+)
+
+func TestChainUpgradeCheck(t *testing.T) {
+	var nilUpgrade *ChainUpgrade
+	require.NoError(t, nilUpgrade.check("chain-1", 100))
+	require.Empty(t, nilUpgrade.ChainID())
+
+	upgrade := NewChainUpgrade("chain-1", nil)
+	require.NoError(t, upgrade.check("chain-1", 100))
+	require.IsType(t, &ChainIDMismatchError{}, upgrade.check("chain-2", 100))
+
+	require.Error(t, upgrade.SetPlan(&UpgradePlan{ChainID: "chain-0", HaltHeight: 10, NewChainID: "chain-2"}))
+	plan := UpgradePlan{ChainID: "chain-1", HaltHeight: 100, NewChainID: "chain-2", StartHeight: 1}
+	require.NoError(t, upgrade.SetPlan(&plan))
+	require.NoError(t, upgrade.check("chain-1", 100))
+	err := upgrade.check("chain-1", 101)
+	require.IsType(t, &HaltHeightError{}, err)
+	require.Contains(t, err.Error(), "chain-1 halts at height 100 for the upgrade to chain-2")
+
+	require.True(t, upgrade.switchTo(plan))
+	require.Equal(t, "chain-2", upgrade.ChainID())
+	require.Nil(t, upgrade.Plan())
+	require.NoError(t, upgrade.check("chain-2", 101))
+	require.IsType(t, &ChainIDMismatchError{}, upgrade.check("chain-1", 101))
+
+	// already applied
+	require.False(t, upgrade.switchTo(plan))
+}
+
+func TestSignStateSwitchChain(t *testing.T) {
+	dir := t.TempDir()
+	signState, err := LoadOrCreateSignState(filepath.Join(dir, "chain-1_state.json"))
+	require.NoError(t, err)
+	require.NoError(t, signState.Save(NewSignStateConsensus(100, 0, stepPrecommit), nil, false))
+
+	newFile := filepath.Join(dir, "chain-2_state.json")
+	require.NoError(t, signState.switchChain(newFile, 1, nil))
+	require.Equal(t, HRSKey{Height: 1}, HRSKey{Height: signState.Height, Round: signState.Round, Step: signState.Step})
+	saved, err := LoadSignState(newFile)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), saved.Height)
+
+	// the old chain keeps its sign state
+	old, err := LoadSignState(filepath.Join(dir, "chain-1_state.json"))
+	require.NoError(t, err)
+	require.Equal(t, int64(100), old.Height)
+
+	// a sign state of the new chain above the start height is kept
+	require.NoError(t, signState.Save(NewSignStateConsensus(5, 1, stepPrevote), nil, false))
+	require.NoError(t, signState.switchChain(newFile, 1, nil))
+	require.Equal(t, int64(5), signState.Height)
+	require.Equal(t, int64(1), signState.Round)
+}
+
+func TestChainUpgradeEvents(t *testing.T) {
+	privateKey := tmCryptoEd25519.GenPrivKey()
+	dir := t.TempDir()
+	stateFile := func(kind string) func(string) string {
+		return func(chainID string) string { return filepath.Join(dir, chainID+"_"+kind+".json") }
+	}
+
+	signState, err := LoadOrCreateSignState(stateFile("priv_validator_state")("chain-1"))
+	require.NoError(t, err)
+	shareSignState, err := LoadOrCreateSignState(stateFile("share_sign_state")("chain-1"))
+	require.NoError(t, err)
+
+	upgrade := NewChainUpgrade("chain-1",
+		&UpgradePlan{ChainID: "chain-1", HaltHeight: 100, NewChainID: "chain-2", StartHeight: 1})
+	upgrade.PrivValStateFile = stateFile("priv_validator_state")
+	upgrade.ShareStateFile = stateFile("share_sign_state")
+	var upgraded []UpgradePlan
+	upgrade.OnUpgraded = func(plan UpgradePlan) { upgraded = append(upgraded, plan) }
+
+	cosigner := NewLocalCosigner(LocalCosignerConfig{
+		CosignerKey: CosignerKey{PubKey: privateKey.PubKey(), ID: 1},
+		SignState:   &shareSignState,
+		Total:       2,
+		Threshold:   2,
+		ChainID:     "chain-1",
+		Upgrade:     upgrade,
+	})
+	logger := tmlog.NewNopLogger()
+	validator := NewThresholdValidator(&ThresholdValidatorOpt{
+		Pubkey:    privateKey.PubKey(),
+		Threshold: 2,
+		SignState: signState,
+		Cosigner:  cosigner,
+		Logger:    logger,
+		Upgrade:   upgrade,
+	})
+	f := &fsm{
+		logger:             logger,
+		cosigner:           cosigner,
+		thresholdValidator: validator,
+		Upgrade:            upgrade,
+	}
+
+	f.handleLSSEvent(`{"Height":100,"Round":0,"Step":3,"ChainID":"chain-1"}`)
+	require.Equal(t, int64(100), validator.lastSignedHRS().Height)
+
+	_, err = cosigner.GetEphemeralSecretParts(HRSTKey{Height: 101, Step: stepPropose})
+	require.IsType(t, &HaltHeightError{}, err)
+
+	f.handleChainUpgradeEvent(`{"chain_id":"chain-1","halt_height":100,"new_chain_id":"chain-2","start_height":1}`)
+	require.Equal(t, "chain-2", upgrade.ChainID())
+	require.Equal(t, "chain-2", cosigner.getChainID())
+	require.Equal(t, HRSTKey{Height: 1}, validator.lastSignedHRS())
+	require.Equal(t, HRSTKey{Height: 1}, cosigner.lastSignedHRS())
+	require.Len(t, upgraded, 1)
+
+	// raft replays the entries before and including the upgrade after a restart
+	f.handleLSSEvent(`{"Height":100,"Round":0,"Step":3,"ChainID":"chain-1"}`)
+	f.handleChainUpgradeEvent(`{"chain_id":"chain-1","halt_height":100,"new_chain_id":"chain-2","start_height":1}`)
+	require.Equal(t, HRSTKey{Height: 1}, validator.lastSignedHRS())
+	require.Len(t, upgraded, 1)
+
+	f.handleLSSEvent(`{"Height":2,"Round":0,"Step":3,"ChainID":"chain-2"}`)
+	require.Equal(t, int64(2), validator.lastSignedHRS().Height)
+	require.Equal(t, int64(2), cosigner.lastSignedHRS().Height)
+
+	// saved asynchronously to the files of the new chain
+	for _, file := range []string{stateFile("priv_validator_state")("chain-2"), stateFile("share_sign_state")("chain-2")} {
+		require.Eventually(t, func() bool {
+			saved, err := LoadSignState(file)
+			return err == nil && saved.Height == 2
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+}
@@ -0,0 +1,110 @@
+package signer
+
+import (
+	"fmt"
+	"time"
+
+	tmLog "github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/service"
+)
+
+const (
+	// DefaultPeerClockSkewWarning is the default clock skew to a peer above which a warning is logged
+	DefaultPeerClockSkewWarning = 500 * time.Millisecond
+
+	// how often the clock skew to the peers is measured
+	clockSkewInterval = 30 * time.Second
+)
+
+// ClockSkewError is returned when the timestamp in the sign bytes is further ahead of the local clock
+// than the maximum clock skew
+type ClockSkewError struct {
+	msg string
+}
+
+func (e *ClockSkewError) Error() string { return e.msg }
+
+func newClockSkewError(timestamp time.Time, skew, maxSkew time.Duration) *ClockSkewError {
+	return &ClockSkewError{
+		msg: fmt.Sprintf("timestamp %s is %s ahead of the local clock, maximum is %s",
+			timestamp.UTC().Format(time.RFC3339Nano), skew, maxSkew),
+	}
+}
+
+// checkClockSkew returns a ClockSkewError if the timestamp is more than maxSkew ahead of the local clock.
+// Timestamps in the past are not checked, since sentries may ask to sign again a vote or proposal
+// with its original timestamp. A maxSkew of zero disables the check.
+func checkClockSkew(maxSkew time.Duration, timestamp time.Time) error {
+	if maxSkew == 0 {
+		return nil
+	}
+	if skew := time.Until(timestamp); skew > maxSkew {
+		totalClockSkewRejections.Inc()
+		return newClockSkewError(timestamp, skew, maxSkew)
+	}
+	return nil
+}
+
+// ClockSkewMonitor periodically measures the clock skew to the peers of the threshold validator,
+// and logs a warning for each peer whose clock is off by more than the limit.
+type ClockSkewMonitor struct {
+	service.BaseService
+
+	thresholdValidator *ThresholdValidator
+	limit              time.Duration
+	logger             tmLog.Logger
+}
+
+// NewClockSkewMonitor returns a new ClockSkewMonitor for the peers of the threshold validator
+func NewClockSkewMonitor(
+	logger tmLog.Logger,
+	thresholdValidator *ThresholdValidator,
+	limit time.Duration,
+) *ClockSkewMonitor {
+	m := &ClockSkewMonitor{
+		thresholdValidator: thresholdValidator,
+		limit:              limit,
+		logger:             logger,
+	}
+	m.BaseService = *service.NewBaseService(logger, "ClockSkewMonitor", m)
+	return m
+}
+
+// OnStart starts measuring the clock skew to the peers.
+// Implements service.Service
+func (m *ClockSkewMonitor) OnStart() error {
+	go m.run()
+	return nil
+}
+
+func (m *ClockSkewMonitor) run() {
+	ticker := time.NewTicker(clockSkewInterval)
+	defer ticker.Stop()
+	for {
+		m.check()
+		select {
+		case <-m.Quit():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check measures the clock skew to each of the peers once
+func (m *ClockSkewMonitor) check() {
+	for _, peer := range pingPeers(m.thresholdValidator.getPeers()) {
+		if peer.Error != "" {
+			continue
+		}
+		skew := time.Duration(peer.ClockSkew)
+		peerClockSkew.WithLabelValues(fmt.Sprint(peer.ShareID)).Set(skew.Seconds())
+		if skew > m.limit || skew < -m.limit {
+			totalPeerClockSkewWarnings.WithLabelValues(fmt.Sprint(peer.ShareID)).Inc()
+			m.logger.Error("Clock skew to peer is above limit",
+				"id", peer.ShareID,
+				"skew", skew.Round(time.Millisecond),
+				"limit", m.limit,
+			)
+		}
+	}
+}
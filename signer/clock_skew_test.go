@@ -0,0 +1,55 @@
+package signer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tm "github.com/tendermint/tendermint/types"
+)
+
+func TestCheckClockSkew(t *testing.T) {
+	now := time.Now()
+
+	// disabled
+	require.NoError(t, checkClockSkew(0, now.Add(time.Hour)))
+
+	require.NoError(t, checkClockSkew(5*time.Second, now))
+	require.NoError(t, checkClockSkew(5*time.Second, now.Add(time.Second)))
+
+	// timestamps in the past are not checked
+	require.NoError(t, checkClockSkew(5*time.Second, now.Add(-time.Hour)))
+
+	err := checkClockSkew(5*time.Second, now.Add(time.Minute))
+	require.IsType(t, &ClockSkewError{}, err)
+}
+
+func TestLocalCosignerRejectsFutureTimestamp(t *testing.T) {
+	cosigner := &LocalCosigner{chainID: "chain-id", maxClockSkew: 5 * time.Second}
+
+	vote := tmproto.Vote{
+		Height:    1,
+		Round:     0,
+		Type:      tmproto.PrevoteType,
+		Timestamp: time.Now().Add(time.Minute),
+	}
+	_, err := cosigner.SetEphemeralSecretPartsAndSign(CosignerSetEphemeralSecretPartsAndSignRequest{
+		HRST:      HRSTKey{Height: 1, Round: 0, Step: stepPrevote, Timestamp: vote.Timestamp.UnixNano()},
+		SignBytes: tm.VoteSignBytes("chain-id", &vote),
+	})
+	require.IsType(t, &ClockSkewError{}, err)
+}
+
+func TestPingPeersClockSkew(t *testing.T) {
+	address := serveLastSignState(t, NewSignStateConsensus(1, 0, stepPrevote))
+
+	peers := pingPeers([]Cosigner{NewRemoteCosigner(2, address)})
+	require.Len(t, peers, 1)
+	require.Empty(t, peers[0].Error)
+
+	// same clock on both ends, so the skew is at most the round trip time
+	skew := time.Duration(peers[0].ClockSkew)
+	require.LessOrEqual(t, skew, time.Duration(peers[0].Latency))
+	require.GreaterOrEqual(t, skew, -time.Duration(peers[0].Latency))
+}
@@ -0,0 +1,82 @@
+package signer
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	proto "github.com/strangelove-ventures/horcrux/signer/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	gproto "google.golang.org/protobuf/proto"
+)
+
+// maximum difference between the timestamp of a signed cluster request and the clock of the raft leader,
+// a captured request can not be replayed later
+const clusterRequestMaxAge = 30 * time.Second
+
+// clusterRequestDigest returns the digest that is signed for a cluster request. It covers the type of the
+// request, which identifies the RPC, the source, the timestamp and the request without its signature.
+func clusterRequestDigest(req gproto.Message, sourceID int32, timestamp int64) ([]byte, error) {
+	unsigned := gproto.Clone(req).ProtoReflect()
+	auth := unsigned.Descriptor().Fields().ByName("auth")
+	if auth == nil {
+		return nil, fmt.Errorf("%s is not a cluster request", unsigned.Descriptor().FullName())
+	}
+	unsigned.Clear(auth)
+	bz, err := gproto.MarshalOptions{Deterministic: true}.Marshal(unsigned.Interface())
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "%s/%d/%d/", unsigned.Descriptor().FullName(), sourceID, timestamp)
+	h.Write(bz)
+	return h.Sum(nil), nil
+}
+
+// SignClusterRequest signs a request to the raft leader that changes the state of the whole cluster
+// with the RSA key of the cosigner with the share ID. The leader only accepts signed cluster requests,
+// since the gRPC port of the cosigners is not authenticated.
+func SignClusterRequest(id int, rsaKey *rsa.PrivateKey, req gproto.Message) (*proto.ClusterRequestAuth, error) {
+	timestamp := time.Now().UnixNano()
+	digest, err := clusterRequestDigest(req, int32(id), timestamp)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := rsa.SignPSS(rand.Reader, rsaKey, crypto.SHA256, digest, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.ClusterRequestAuth{SourceID: int32(id), Timestamp: timestamp, Signature: signature}, nil
+}
+
+// signClusterRequest signs a cluster request with the RSA key of our cosigner
+func (cosigner *LocalCosigner) signClusterRequest(req gproto.Message) (*proto.ClusterRequestAuth, error) {
+	return SignClusterRequest(cosigner.key.ID, &cosigner.rsaKey, req)
+}
+
+// verifyClusterRequest checks that a cluster request has been signed recently by a cosigner of the cluster
+func (cosigner *LocalCosigner) verifyClusterRequest(req gproto.Message, auth *proto.ClusterRequestAuth) error {
+	if auth == nil {
+		return status.Error(codes.Unauthenticated, "cluster request is not signed by a cosigner")
+	}
+	if age := time.Since(time.Unix(0, auth.Timestamp)); age > clusterRequestMaxAge || age < -clusterRequestMaxAge {
+		return status.Errorf(codes.Unauthenticated, "cluster request was signed %s ago, at most %s is accepted",
+			age.Round(time.Millisecond), clusterRequestMaxAge)
+	}
+	peer, ok := cosigner.getPeer(int(auth.SourceID))
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "cluster request signed by unknown cosigner %d", auth.SourceID)
+	}
+	digest, err := clusterRequestDigest(req, auth.SourceID, auth.Timestamp)
+	if err != nil {
+		return err
+	}
+	if err := rsa.VerifyPSS(&peer.PublicKey, crypto.SHA256, digest, auth.Signature, nil); err != nil {
+		return status.Errorf(codes.Unauthenticated, "invalid signature of cosigner %d on cluster request", auth.SourceID)
+	}
+	return nil
+}
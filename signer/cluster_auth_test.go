@@ -0,0 +1,69 @@
+package signer
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"testing"
+	"time"
+
+	proto "github.com/strangelove-ventures/horcrux/signer/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClusterRequestAuth(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	cosigner := &LocalCosigner{peers: map[int]CosignerPeer{
+		1: {ID: 1, PublicKey: rsaKey.PublicKey},
+		2: {ID: 2, PublicKey: otherKey.PublicKey},
+	}}
+
+	req := &proto.CosignerGRPCRecordAdminActionRequest{Action: []byte(`{"command":"cluster remove-peer"}`)}
+	auth, err := SignClusterRequest(1, rsaKey, req)
+	require.NoError(t, err)
+	req.Auth = auth
+	require.NoError(t, cosigner.verifyClusterRequest(req, req.GetAuth()))
+
+	requireUnauthenticated := func(err error) {
+		require.Error(t, err)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+
+	requireUnauthenticated(cosigner.verifyClusterRequest(req, nil))
+
+	// the signature covers the request
+	changed := &proto.CosignerGRPCRecordAdminActionRequest{Action: []byte(`{"command":"elect"}`), Auth: auth}
+	requireUnauthenticated(cosigner.verifyClusterRequest(changed, changed.GetAuth()))
+
+	// and the RPC, a signed request of another RPC with the same content is refused
+	emptyAuth, err := SignClusterRequest(1, rsaKey, &proto.CosignerGRPCRecordAdminActionRequest{})
+	require.NoError(t, err)
+	pause := &proto.CosignerGRPCSetClusterPauseRequest{Auth: emptyAuth}
+	requireUnauthenticated(cosigner.verifyClusterRequest(pause, pause.GetAuth()))
+	signState := &proto.CosignerGRPCSetClusterSignStateRequest{Auth: emptyAuth}
+	requireUnauthenticated(cosigner.verifyClusterRequest(signState, signState.GetAuth()))
+
+	// and the source
+	forged := &proto.ClusterRequestAuth{SourceID: 2, Timestamp: auth.Timestamp, Signature: auth.Signature}
+	requireUnauthenticated(cosigner.verifyClusterRequest(req, forged))
+
+	unknown, err := SignClusterRequest(3, rsaKey, req)
+	require.NoError(t, err)
+	requireUnauthenticated(cosigner.verifyClusterRequest(req, unknown))
+
+	// old requests can not be replayed
+	timestamp := time.Now().Add(-2 * clusterRequestMaxAge).UnixNano()
+	digest, err := clusterRequestDigest(req, 1, timestamp)
+	require.NoError(t, err)
+	signature, err := rsa.SignPSS(rand.Reader, rsaKey, crypto.SHA256, digest, nil)
+	require.NoError(t, err)
+	stale := &proto.ClusterRequestAuth{SourceID: 1, Timestamp: timestamp, Signature: signature}
+	err = cosigner.verifyClusterRequest(req, stale)
+	requireUnauthenticated(err)
+	require.Contains(t, err.Error(), "at most 30s is accepted")
+}
@@ -0,0 +1,123 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/privval"
+	rpchttp "github.com/tendermint/tendermint/rpc/client/http"
+	tm "github.com/tendermint/tendermint/types"
+)
+
+// DoubleSignCheck looks for commit signatures of the validator in the most recent blocks of the
+// chain that are above the local sign state, which would mean the sign state has been reset.
+type DoubleSignCheck struct {
+	// CometBFT RPC address of a node of the chain, i.e. http://sentry-1:26657
+	RPCAddress string
+
+	// number of most recent blocks to check
+	Heights int64
+}
+
+// SignedCommit is a commit of a block that the validator signed
+type SignedCommit struct {
+	Height int64
+	Round  int32
+}
+
+// HighestSignedCommit returns the highest commit above the given height, within the most recent
+// blocks, that includes a signature of the validator. Returns nil if there is none.
+func (c DoubleSignCheck) HighestSignedCommit(
+	ctx context.Context,
+	address crypto.Address,
+	aboveHeight int64,
+) (*SignedCommit, error) {
+	client, err := rpchttp.New(c.RPCAddress, "/websocket")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create RPC client for %s: %w", c.RPCAddress, err)
+	}
+
+	status, err := client.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status from %s: %w", c.RPCAddress, err)
+	}
+	latest := status.SyncInfo.LatestBlockHeight
+
+	lowest := latest - c.Heights + 1
+	if lowest <= aboveHeight {
+		lowest = aboveHeight + 1
+	}
+	for height := latest; height >= lowest; height-- {
+		h := height
+		res, err := client.Commit(ctx, &h)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get commit at height %d from %s: %w", height, c.RPCAddress, err)
+		}
+		commit := res.SignedHeader.Commit
+		if commit == nil {
+			continue
+		}
+		for _, sig := range commit.Signatures {
+			if sig.BlockIDFlag != tm.BlockIDFlagAbsent && sig.ValidatorAddress.String() == address.String() {
+				return &SignedCommit{Height: commit.Height, Round: commit.Round}, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// LatestCommit returns the chain ID of the node at the CometBFT RPC address,
+// and the height and round of the latest commit it has
+func LatestCommit(ctx context.Context, rpcAddress string) (string, *SignedCommit, error) {
+	client, err := rpchttp.New(rpcAddress, "/websocket")
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to create RPC client for %s: %w", rpcAddress, err)
+	}
+
+	status, err := client.Status(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get status from %s: %w", rpcAddress, err)
+	}
+	latest := status.SyncInfo.LatestBlockHeight
+
+	res, err := client.Commit(ctx, &latest)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get commit at height %d from %s: %w", latest, rpcAddress, err)
+	}
+	commit := res.SignedHeader.Commit
+	if commit == nil {
+		return "", nil, fmt.Errorf("%s has no commit at height %d", rpcAddress, latest)
+	}
+	return status.NodeInfo.Network, &SignedCommit{Height: commit.Height, Round: commit.Round}, nil
+}
+
+// SignStateConsensus returns the sign state at the precommit of the commit, without sign bytes
+func (c SignedCommit) SignStateConsensus() SignStateConsensus {
+	return NewSignStateConsensus(c.Height, int64(c.Round), stepPrecommit)
+}
+
+// FastForward moves the sign state to the precommit of the signed commit without sign bytes, so that
+// nothing at or below it is signed again. Does nothing if the sign state is already at or above it.
+func (signState *SignState) FastForward(commit *SignedCommit) error {
+	if signState.GetErrorIfLessOrEqual(commit.Height, int64(commit.Round), stepPrecommit, nil) != nil {
+		return nil
+	}
+	return signState.Save(NewSignStateConsensus(commit.Height, int64(commit.Round), stepPrecommit), nil, false)
+}
+
+// FastForwardFilePV moves the last sign state of a single signer to the precommit of the signed commit
+// without sign bytes. Does nothing if the last sign state is already at or above it.
+func FastForwardFilePV(filePV *privval.FilePV, commit *SignedCommit) {
+	lss := &filePV.LastSignState
+	if lss.Height > commit.Height || (lss.Height == commit.Height &&
+		(lss.Round > commit.Round || (lss.Round == commit.Round && lss.Step >= stepPrecommit))) {
+		return
+	}
+	lss.Height = commit.Height
+	lss.Round = commit.Round
+	lss.Step = stepPrecommit
+	lss.Signature = nil
+	lss.SignBytes = nil
+	lss.Save()
+}
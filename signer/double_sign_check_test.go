@@ -0,0 +1,114 @@
+package signer
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	tmCryptoEd25519 "github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/p2p"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcTypes "github.com/tendermint/tendermint/rpc/jsonrpc/types"
+	tm "github.com/tendermint/tendermint/types"
+)
+
+// mockChainRPC serves the status and commit CometBFT RPC methods for a chain at the latest height,
+// where the validator address signed the commits at the given heights.
+func mockChainRPC(t *testing.T, latest int64, address crypto.Address, signed map[int64]bool) *httptest.Server {
+	other := tmCryptoEd25519.GenPrivKey().PubKey().Address()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req rpcTypes.RPCRequest
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+
+		var result interface{}
+		switch req.Method {
+		case "status":
+			result = &ctypes.ResultStatus{
+				NodeInfo: p2p.DefaultNodeInfo{Network: "chain-id"},
+				SyncInfo: ctypes.SyncInfo{LatestBlockHeight: latest},
+			}
+		case "commit":
+			var params struct {
+				Height string `json:"height"`
+			}
+			require.NoError(t, json.Unmarshal(req.Params, &params))
+			height, err := strconv.ParseInt(params.Height, 10, 64)
+			require.NoError(t, err)
+
+			signatures := []tm.CommitSig{{BlockIDFlag: tm.BlockIDFlagCommit, ValidatorAddress: other}}
+			if signed[height] {
+				signatures = append(signatures, tm.CommitSig{BlockIDFlag: tm.BlockIDFlagCommit, ValidatorAddress: address})
+			} else {
+				signatures = append(signatures, tm.CommitSig{BlockIDFlag: tm.BlockIDFlagAbsent})
+			}
+			result = &ctypes.ResultCommit{SignedHeader: tm.SignedHeader{
+				Header: &tm.Header{Height: height},
+				Commit: &tm.Commit{Height: height, Round: 1, Signatures: signatures},
+			}}
+		default:
+			t.Fatalf("unexpected RPC method %s", req.Method)
+		}
+
+		bz, err := json.Marshal(rpcTypes.NewRPCSuccessResponse(req.ID, result))
+		require.NoError(t, err)
+		_, _ = w.Write(bz)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestDoubleSignCheckHighestSignedCommit(t *testing.T) {
+	address := tmCryptoEd25519.GenPrivKey().PubKey().Address()
+	server := mockChainRPC(t, 100, address, map[int64]bool{90: true, 95: true})
+	check := DoubleSignCheck{RPCAddress: server.URL, Heights: 10}
+
+	commit, err := check.HighestSignedCommit(context.Background(), address, 80)
+	require.NoError(t, err)
+	require.Equal(t, &SignedCommit{Height: 95, Round: 1}, commit)
+
+	// the local sign state is already past the signed commits
+	commit, err = check.HighestSignedCommit(context.Background(), address, 95)
+	require.NoError(t, err)
+	require.Nil(t, commit)
+
+	// signed commits older than the checked heights are not found
+	check.Heights = 5
+	commit, err = check.HighestSignedCommit(context.Background(), address, 80)
+	require.NoError(t, err)
+	require.Nil(t, commit)
+}
+
+func TestLatestCommit(t *testing.T) {
+	address := tmCryptoEd25519.GenPrivKey().PubKey().Address()
+	server := mockChainRPC(t, 100, address, nil)
+
+	chainID, commit, err := LatestCommit(context.Background(), server.URL)
+	require.NoError(t, err)
+	require.Equal(t, "chain-id", chainID)
+	require.Equal(t, &SignedCommit{Height: 100, Round: 1}, commit)
+	require.Equal(t, NewSignStateConsensus(100, 1, stepPrecommit), commit.SignStateConsensus())
+}
+
+func TestSignStateFastForward(t *testing.T) {
+	stateFile := testSignStateFile(t, "state.json")
+	signState, err := LoadOrCreateSignState(stateFile)
+	require.NoError(t, err)
+
+	require.NoError(t, signState.FastForward(&SignedCommit{Height: 95, Round: 1}))
+	require.NoError(t, signState.FastForward(&SignedCommit{Height: 90, Round: 0}))
+
+	loaded, err := LoadSignState(stateFile)
+	require.NoError(t, err)
+	require.Equal(t, int64(95), loaded.Height)
+	require.Equal(t, int64(1), loaded.Round)
+	require.Equal(t, stepPrecommit, loaded.Step)
+
+	// the precommit of the signed commit is never signed again
+	_, err = loaded.CheckHRS(HRSTKey{Height: 95, Round: 1, Step: stepPrecommit})
+	require.Error(t, err)
+}
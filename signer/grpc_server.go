@@ -2,31 +2,61 @@ package signer
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/raft"
 	proto "github.com/strangelove-ventures/horcrux/signer/proto"
+	"github.com/tendermint/tendermint/libs/log"
 )
 
 type GRPCServer struct {
 	cosigner           *LocalCosigner
 	thresholdValidator *ThresholdValidator
 	raftStore          *RaftStore
+	logger             log.Logger
+	version            string
 	proto.UnimplementedCosignerGRPCServer
 }
 
+var (
+	errLeaderlessMode = errors.New("cosigner is running in leaderless mode, raft is not available")
+	errWitness        = errors.New("witness nodes hold no key share and do not sign")
+)
+
 func (rpc *GRPCServer) SignBlock(
 	ctx context.Context, req *proto.CosignerGRPCSignBlockRequest) (*proto.CosignerGRPCSignBlockResponse, error) {
+	if rpc.cosigner == nil {
+		return nil, errWitness
+	}
+	if chainID := rpc.cosigner.getChainID(); chainID != "" && req.ChainID != chainID {
+		return nil, newChainIDMismatchError(chainID, req.ChainID)
+	}
 	block := &Block{
 		Height:    req.Block.GetHeight(),
 		Round:     req.Block.GetRound(),
 		Step:      int8(req.Block.GetStep()),
 		SignBytes: req.Block.GetSignBytes(),
 		Timestamp: time.Unix(0, req.Block.GetTimestamp()),
+		Source:    req.Block.GetSource(),
+	}
+	if req.GetSourceID() != 0 {
+		if block.Source == "" {
+			block.Source = fmt.Sprintf("cosigner %d", req.GetSourceID())
+		} else {
+			block.Source = fmt.Sprintf("%s via cosigner %d", block.Source, req.GetSourceID())
+		}
 	}
 	res, _, err := rpc.thresholdValidator.SignBlock(req.ChainID, block)
 	if err != nil {
+		var conflict *ConflictingDataError
+		if errors.As(err, &conflict) {
+			return nil, conflictingDataErrorToRemote(conflict)
+		}
 		return nil, err
 	}
 	return &proto.CosignerGRPCSignBlockResponse{
@@ -38,16 +68,19 @@ func (rpc *GRPCServer) SetEphemeralSecretPartsAndSign(
 	ctx context.Context,
 	req *proto.CosignerGRPCSetEphemeralSecretPartsAndSignRequest,
 ) (*proto.CosignerGRPCSetEphemeralSecretPartsAndSignResponse, error) {
+	if rpc.cosigner == nil {
+		return nil, errWitness
+	}
 	res, err := rpc.cosigner.SetEphemeralSecretPartsAndSign(CosignerSetEphemeralSecretPartsAndSignRequest{
 		EncryptedSecrets: CosignerEphemeralSecretPartsFromProto(req.GetEncryptedSecrets()),
 		HRST:             HRSTKeyFromProto(req.GetHrst()),
 		SignBytes:        req.GetSignBytes(),
 	})
 	if err != nil {
-		rpc.raftStore.logger.Error("Failed to sign with share", "error", err)
+		rpc.logger.Error("Failed to sign with share", "error", err)
 		return nil, err
 	}
-	rpc.raftStore.logger.Info("Signed with share",
+	rpc.logger.Info("Signed with share",
 		"height", req.Hrst.Height,
 		"round", req.Hrst.Round,
 		"step", req.Hrst.Step,
@@ -63,6 +96,9 @@ func (rpc *GRPCServer) GetEphemeralSecretParts(
 	ctx context.Context,
 	req *proto.CosignerGRPCGetEphemeralSecretPartsRequest,
 ) (*proto.CosignerGRPCGetEphemeralSecretPartsResponse, error) {
+	if rpc.cosigner == nil {
+		return nil, errWitness
+	}
 	res, err := rpc.cosigner.GetEphemeralSecretParts(HRSTKeyFromProto(req.GetHrst()))
 	if err != nil {
 		return nil, err
@@ -76,9 +112,12 @@ func (rpc *GRPCServer) TransferLeadership(
 	ctx context.Context,
 	req *proto.CosignerGRPCTransferLeadershipRequest,
 ) (*proto.CosignerGRPCTransferLeadershipResponse, error) {
+	if rpc.raftStore == nil {
+		return nil, errLeaderlessMode
+	}
 	leaderID := req.GetLeaderID()
 	if leaderID != "" {
-		for _, peer := range rpc.raftStore.Peers {
+		for _, peer := range rpc.raftStore.getPeers() {
 			thisPeerID := fmt.Sprint(peer.GetID())
 			if thisPeerID == leaderID {
 				peerRaftAddress := p2pURLToRaftAddress(peer.GetAddress())
@@ -97,6 +136,218 @@ func (rpc *GRPCServer) GetLeader(
 	ctx context.Context,
 	req *proto.CosignerGRPCGetLeaderRequest,
 ) (*proto.CosignerGRPCGetLeaderResponse, error) {
+	if rpc.raftStore == nil {
+		return nil, errLeaderlessMode
+	}
 	leader := rpc.raftStore.GetLeader()
 	return &proto.CosignerGRPCGetLeaderResponse{Leader: string(leader)}, nil
 }
+
+func (rpc *GRPCServer) AddPeer(
+	ctx context.Context,
+	req *proto.CosignerGRPCAddPeerRequest,
+) (*proto.CosignerGRPCAddPeerResponse, error) {
+	if rpc.raftStore == nil {
+		return nil, errLeaderlessMode
+	}
+	if err := rpc.raftStore.AddPeer(int(req.GetShareID()), req.GetP2PAddr()); err != nil {
+		return nil, err
+	}
+	rpc.logger.Info("Added cosigner to cluster", "id", req.GetShareID(), "address", req.GetP2PAddr())
+	return &proto.CosignerGRPCAddPeerResponse{}, nil
+}
+
+func (rpc *GRPCServer) RemovePeer(
+	ctx context.Context,
+	req *proto.CosignerGRPCRemovePeerRequest,
+) (*proto.CosignerGRPCRemovePeerResponse, error) {
+	if rpc.raftStore == nil {
+		return nil, errLeaderlessMode
+	}
+	if err := rpc.raftStore.RemovePeer(int(req.GetShareID())); err != nil {
+		return nil, err
+	}
+	rpc.logger.Info("Removed cosigner from cluster", "id", req.GetShareID())
+	return &proto.CosignerGRPCRemovePeerResponse{}, nil
+}
+
+func (rpc *GRPCServer) GetStatus(
+	ctx context.Context,
+	req *proto.CosignerGRPCGetStatusRequest,
+) (*proto.CosignerGRPCGetStatusResponse, error) {
+	res := &proto.CosignerGRPCGetStatusResponse{
+		Version: rpc.version,
+	}
+
+	thresholdValidator := rpc.thresholdValidator
+	var peers []Cosigner
+	if rpc.cosigner == nil {
+		// witnesses report their raft node ID and the replicated last sign state
+		id, _ := strconv.Atoi(rpc.raftStore.NodeID)
+		res.ShareID = int32(id)
+		res.Witness = true
+		res.LastSignState = rpc.raftStore.witnessLastSignedHRS().toProto()
+	} else {
+		res.ShareID = int32(rpc.cosigner.GetID())
+		res.ShareSignState = rpc.cosigner.lastSignedHRS().toProto()
+	}
+
+	if rpc.raftStore == nil {
+		res.RaftState = "Leaderless"
+		peers = thresholdValidator.getPeers()
+	} else {
+		// the threshold validator is set on the raft store after the gRPC server has been started
+		thresholdValidator = rpc.raftStore.thresholdValidator
+		var lastContact time.Duration
+		res.RaftState, res.Term, res.CommitIndex, res.AppliedIndex, lastContact = rpc.raftStore.raftStatus()
+		res.LastContact = int64(lastContact)
+		peers = rpc.raftStore.getPeers()
+		res.SignRequestArrivals = rpc.raftStore.signRequestArrivals()
+	}
+	if thresholdValidator != nil {
+		res.LastSignState = thresholdValidator.lastSignedHRS().toProto()
+	}
+
+	res.Peers = pingPeers(peers)
+	res.Sentries = int32(getConnectedSentries())
+	if rpc.cosigner != nil {
+		res.Paused = rpc.cosigner.pause.isPaused()
+	}
+
+	return res, nil
+}
+
+// pingPeers measures the round-trip latency and the clock skew to each of the peers concurrently.
+// The clock skew is the difference between the clock reading of the peer and our clock
+// halfway through the round trip.
+func pingPeers(peers []Cosigner) []*proto.PeerLatency {
+	latencies := make([]*proto.PeerLatency, len(peers))
+	var wg sync.WaitGroup
+	for i, peer := range peers {
+		wg.Add(1)
+		go func(i int, peer Cosigner) {
+			defer wg.Done()
+			latency := &proto.PeerLatency{
+				ShareID: int32(peer.GetID()),
+				Address: peer.GetAddress(),
+			}
+			start := time.Now()
+			peerTime, err := NewRemoteCosigner(peer.GetID(), peer.GetAddress()).Ping()
+			if err != nil {
+				latency.Error = err.Error()
+			} else {
+				rtt := time.Since(start)
+				latency.Latency = int64(rtt)
+				latency.ClockSkew = int64(peerTime.Sub(start.Add(rtt / 2)))
+			}
+			latencies[i] = latency
+		}(i, peer)
+	}
+	wg.Wait()
+	return latencies
+}
+
+// GetLastSignState returns the last sign state of the validator, including the combined
+// signature, so that a cosigner restored from an old backup can catch up on startup.
+func (rpc *GRPCServer) GetLastSignState(
+	ctx context.Context,
+	req *proto.CosignerGRPCGetLastSignStateRequest,
+) (*proto.CosignerGRPCGetLastSignStateResponse, error) {
+	var ssc SignStateConsensus
+	switch {
+	case rpc.cosigner == nil:
+		ssc = rpc.raftStore.witnessSignState.consensus(&rpc.raftStore.witnessSignStateMutex)
+	case rpc.raftStore != nil && rpc.raftStore.thresholdValidator != nil:
+		// the threshold validator is set on the raft store after the gRPC server has been started
+		tv := rpc.raftStore.thresholdValidator
+		ssc = tv.lastSignState.consensus(&tv.lastSignStateMutex)
+	case rpc.thresholdValidator != nil:
+		tv := rpc.thresholdValidator
+		ssc = tv.lastSignState.consensus(&tv.lastSignStateMutex)
+	default:
+		return nil, errors.New("threshold validator not yet initialized")
+	}
+	return &proto.CosignerGRPCGetLastSignStateResponse{
+		Hrst:      HRSTKey{Height: ssc.Height, Round: ssc.Round, Step: ssc.Step}.toProto(),
+		SignBytes: ssc.SignBytes,
+		Signature: ssc.Signature,
+	}, nil
+}
+
+// RecordAdminAction replicates an admin action that affects the whole cluster through raft
+func (rpc *GRPCServer) RecordAdminAction(
+	ctx context.Context,
+	req *proto.CosignerGRPCRecordAdminActionRequest,
+) (*proto.CosignerGRPCRecordAdminActionResponse, error) {
+	if rpc.raftStore == nil {
+		return nil, errLeaderlessMode
+	}
+	if rpc.cosigner == nil {
+		return nil, errWitness
+	}
+	if err := rpc.cosigner.verifyClusterRequest(req, req.GetAuth()); err != nil {
+		return nil, err
+	}
+	var action AdminAction
+	if err := json.Unmarshal(req.GetAction(), &action); err != nil {
+		return nil, fmt.Errorf("invalid admin action: %w", err)
+	}
+	if err := rpc.raftStore.RecordAdminAction(action); err != nil {
+		return nil, err
+	}
+	return &proto.CosignerGRPCRecordAdminActionResponse{}, nil
+}
+
+// SetClusterPause replicates a pause or resume of signing on all cosigners through raft
+func (rpc *GRPCServer) SetClusterPause(
+	ctx context.Context,
+	req *proto.CosignerGRPCSetClusterPauseRequest,
+) (*proto.CosignerGRPCSetClusterPauseResponse, error) {
+	if rpc.raftStore == nil {
+		return nil, errLeaderlessMode
+	}
+	if rpc.cosigner == nil {
+		return nil, errWitness
+	}
+	if err := rpc.cosigner.verifyClusterRequest(req, req.GetAuth()); err != nil {
+		return nil, err
+	}
+	err := rpc.raftStore.SetClusterPause(ClusterPause{
+		Paused:       req.GetPaused(),
+		Reason:       req.GetReason(),
+		ResumeHeight: req.GetResumeHeight(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &proto.CosignerGRPCSetClusterPauseResponse{}, nil
+}
+
+// SetClusterSignState replicates a sign state set by the operator to all cosigners through raft
+func (rpc *GRPCServer) SetClusterSignState(
+	ctx context.Context,
+	req *proto.CosignerGRPCSetClusterSignStateRequest,
+) (*proto.CosignerGRPCSetClusterSignStateResponse, error) {
+	if rpc.raftStore == nil {
+		return nil, errLeaderlessMode
+	}
+	if rpc.cosigner == nil {
+		return nil, errWitness
+	}
+	if err := rpc.cosigner.verifyClusterRequest(req, req.GetAuth()); err != nil {
+		return nil, err
+	}
+	hrst := req.GetHrst()
+	ssc := NewSignStateConsensus(hrst.GetHeight(), hrst.GetRound(), int8(hrst.GetStep()))
+	if err := rpc.raftStore.SetClusterSignState(ctx, ssc); err != nil {
+		return nil, err
+	}
+	return &proto.CosignerGRPCSetClusterSignStateResponse{}, nil
+}
+
+func (rpc *GRPCServer) Ping(
+	ctx context.Context,
+	req *proto.CosignerGRPCPingRequest,
+) (*proto.CosignerGRPCPingResponse, error) {
+	return &proto.CosignerGRPCPingResponse{Time: time.Now().UnixNano()}, nil
+}
@@ -0,0 +1,73 @@
+package signer
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"os"
+	"testing"
+
+	proto "github.com/strangelove-ventures/horcrux/signer/proto"
+	"github.com/stretchr/testify/require"
+	tmCryptoEd25519 "github.com/tendermint/tendermint/crypto/ed25519"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+)
+
+func TestGRPCServerGetStatusLeaderless(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	privateKey := tmCryptoEd25519.GenPrivKey()
+
+	stateFile := testSignStateFile(t, "state.json")
+
+	signState, err := LoadOrCreateSignState(stateFile)
+	require.NoError(t, err)
+
+	shareStateFile := testSignStateFile(t, "share_state.json")
+
+	shareSignState, err := LoadOrCreateSignState(shareStateFile)
+	require.NoError(t, err)
+	require.NoError(t, shareSignState.Save(NewSignStateConsensus(5, 1, stepPrecommit), nil, false))
+
+	cosigner := NewLocalCosigner(LocalCosignerConfig{
+		CosignerKey: CosignerKey{PubKey: privateKey.PubKey(), ID: 1},
+		SignState:   &shareSignState,
+		RsaKey:      *rsaKey,
+		Peers:       []CosignerPeer{{ID: 1, PublicKey: rsaKey.PublicKey}},
+		Total:       2,
+		Threshold:   2,
+	})
+
+	logger := tmlog.NewTMLogger(tmlog.NewSyncWriter(os.Stdout))
+	validator := NewThresholdValidator(&ThresholdValidatorOpt{
+		Pubkey:     privateKey.PubKey(),
+		Threshold:  2,
+		SignState:  signState,
+		Cosigner:   cosigner,
+		Peers:      []Cosigner{NewRemoteCosigner(2, "tcp://127.0.0.1:1")},
+		Leaderless: true,
+		Logger:     logger,
+	})
+	require.NoError(t, validator.SaveLastSignedState(NewSignStateConsensus(4, 0, stepPrevote)))
+
+	server := &GRPCServer{
+		cosigner:           cosigner,
+		thresholdValidator: validator,
+		logger:             logger,
+		version:            "v1.0.0",
+	}
+
+	res, err := server.GetStatus(context.Background(), &proto.CosignerGRPCGetStatusRequest{})
+	require.NoError(t, err)
+
+	require.Equal(t, int32(1), res.ShareID)
+	require.Equal(t, "v1.0.0", res.Version)
+	require.Equal(t, "Leaderless", res.RaftState)
+	require.Equal(t, &proto.HRST{Height: 4, Round: 0, Step: int32(stepPrevote)}, res.LastSignState)
+	require.Equal(t, &proto.HRST{Height: 5, Round: 1, Step: int32(stepPrecommit)}, res.ShareSignState)
+
+	require.Len(t, res.Peers, 1)
+	require.Equal(t, int32(2), res.Peers[0].ShareID)
+	require.NotEmpty(t, res.Peers[0].Error)
+}
@@ -0,0 +1,91 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	proto "github.com/strangelove-ventures/horcrux/signer/proto"
+	"github.com/tendermint/tendermint/libs/log"
+	"github.com/tendermint/tendermint/libs/service"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+// GRPCService serves the cosigner gRPC API without a raft cluster.
+// It is used in leaderless mode, where every cosigner coordinates the
+// signing rounds for the requests it receives from its sentries.
+type GRPCService struct {
+	service.BaseService
+
+	// Version of horcrux reported in the cluster status
+	Version string
+
+	listenAddress      string
+	cosigner           *LocalCosigner
+	thresholdValidator *ThresholdValidator
+
+	server *grpc.Server
+	logger log.Logger
+}
+
+// NewGRPCService returns a new GRPCService listening on the p2p address of the cosigner.
+func NewGRPCService(
+	listenAddress string,
+	logger log.Logger,
+	cosigner *LocalCosigner,
+	thresholdValidator *ThresholdValidator,
+) *GRPCService {
+	s := &GRPCService{
+		listenAddress:      listenAddress,
+		cosigner:           cosigner,
+		thresholdValidator: thresholdValidator,
+		logger:             logger,
+	}
+	s.BaseService = *service.NewBaseService(logger, "CosignerGRPCService", s)
+	return s
+}
+
+// OnStart starts the gRPC server
+func (s *GRPCService) OnStart() error {
+	host := p2pURLToRaftAddress(s.listenAddress)
+	_, port, err := net.SplitHostPort(host)
+	if err != nil {
+		return fmt.Errorf("failed to parse local address: %s, %v", host, err)
+	}
+	sock, err := net.Listen("tcp", fmt.Sprintf(":%s", port))
+	if err != nil {
+		return err
+	}
+	s.logger.Info("Cosigner gRPC Listening", "port", port)
+
+	s.server = grpc.NewServer()
+	proto.RegisterCosignerGRPCServer(s.server, &GRPCServer{
+		cosigner:           s.cosigner,
+		thresholdValidator: s.thresholdValidator,
+		logger:             s.logger,
+		version:            s.Version,
+	})
+	reflection.Register(s.server)
+
+	go func() {
+		if err := s.server.Serve(sock); err != nil {
+			s.logger.Error("Cosigner gRPC server stopped", "error", err)
+		}
+	}()
+	return nil
+}
+
+// OnStop stops the gRPC server
+func (s *GRPCService) OnStop() {
+	s.server.GracefulStop()
+}
+
+// Drain waits for the sign requests in progress and synchronously flushes the sign state to disk.
+// Implements Drainer interface
+func (s *GRPCService) Drain(ctx context.Context) error {
+	err := s.thresholdValidator.waitForSignBlocks(ctx)
+	s.thresholdValidator.flushSignState()
+	s.cosigner.flushSignState()
+	return err
+}
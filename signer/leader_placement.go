@@ -0,0 +1,361 @@
+package signer
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/raft"
+	proto "github.com/strangelove-ventures/horcrux/signer/proto"
+)
+
+const (
+	// how often the leader evaluates whether another cosigner would sign faster
+	leaderPlacementInterval = 30 * time.Second
+
+	// minimum time between leadership changes caused by leader placement
+	leaderPlacementCooldown = 5 * time.Minute
+
+	// number of consecutive evaluations another cosigner must be clearly faster
+	leaderPlacementRounds = 3
+
+	// another cosigner is clearly faster if it improves the expected signing latency of
+	// the leader by both the absolute and the relative margin
+	leaderPlacementMinGain      = 5 * time.Millisecond
+	leaderPlacementMinGainRatio = 0.2
+
+	// number of recent sign requests used to find the cosigners that deliver requests first
+	leaderPlacementArrivalWindow = 1000
+)
+
+// placementNode holds the metrics of a cosigner used for leader placement
+type placementNode struct {
+	ID       int
+	Address  string
+	Sentries int
+	// round-trip latency to the other cosigners, by share ID
+	Latencies map[int]time.Duration
+}
+
+// leaderPlacement moves the raft leadership to the cosigner that is expected to sign the fastest.
+// The expected signing latency of a cosigner as leader is the time to forward the sign requests
+// from the cosigners whose sentries deliver them first, plus two round-trips to the slowest
+// of the threshold peers needed for a signing round.
+// Every cosigner records when its own sentries delivered the recent sign requests, the leader
+// compares the arrival times reported by all cosigners to find which one received each request first.
+type leaderPlacement struct {
+	mu sync.Mutex
+
+	// time each of the recent sign requests first arrived from our sentries
+	arrivals     map[HRSKey]time.Time
+	arrivalOrder []HRSKey
+
+	// candidate that has been clearly faster for candidateRounds consecutive evaluations
+	candidate       int
+	candidateRounds int
+
+	// time of the last leadership change seen by, or caused by, leader placement
+	lastChange time.Time
+	wasLeader  bool
+}
+
+func newLeaderPlacement() *leaderPlacement {
+	return &leaderPlacement{
+		arrivals: make(map[HRSKey]time.Time),
+	}
+}
+
+// recordArrival records the time a sign request for the HRS arrived from our sentries,
+// if it is the first arrival of that HRS.
+func (lp *leaderPlacement) recordArrival(hrs HRSKey, t time.Time) {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	if _, ok := lp.arrivals[hrs]; ok {
+		return
+	}
+	lp.arrivals[hrs] = t
+	lp.arrivalOrder = append(lp.arrivalOrder, hrs)
+	if len(lp.arrivalOrder) > leaderPlacementArrivalWindow {
+		delete(lp.arrivals, lp.arrivalOrder[0])
+		lp.arrivalOrder = lp.arrivalOrder[1:]
+	}
+}
+
+// recentArrivals returns the first arrival times of the recent sign requests from our sentries
+func (lp *leaderPlacement) recentArrivals() map[HRSKey]time.Time {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	arrivals := make(map[HRSKey]time.Time, len(lp.arrivals))
+	for hrs, t := range lp.arrivals {
+		arrivals[hrs] = t
+	}
+	return arrivals
+}
+
+// arrivalsToProto encodes arrival times as HRSTs with the arrival time in unix nanoseconds
+func arrivalsToProto(arrivals map[HRSKey]time.Time) []*proto.HRST {
+	hrsts := make([]*proto.HRST, 0, len(arrivals))
+	for hrs, t := range arrivals {
+		hrst := HRSTKey{Height: hrs.Height, Round: hrs.Round, Step: hrs.Step, Timestamp: t.UnixNano()}
+		hrsts = append(hrsts, hrst.toProto())
+	}
+	return hrsts
+}
+
+func arrivalsFromProto(hrsts []*proto.HRST) map[HRSKey]time.Time {
+	arrivals := make(map[HRSKey]time.Time, len(hrsts))
+	for _, hrst := range hrsts {
+		arrivals[HRSKey{Height: hrst.Height, Round: hrst.Round, Step: int8(hrst.Step)}] = time.Unix(0, hrst.Timestamp)
+	}
+	return arrivals
+}
+
+// firstDeliveries returns how many of the recent sign requests each cosigner received first from its sentries,
+// given the arrival times reported by each cosigner by share ID. Ties go to the lower share ID.
+func firstDeliveries(arrivals map[int]map[HRSKey]time.Time) map[int]int {
+	first := make(map[HRSKey]int)
+	for id, nodeArrivals := range arrivals {
+		for hrs, t := range nodeArrivals {
+			firstID, ok := first[hrs]
+			if !ok {
+				first[hrs] = id
+				continue
+			}
+			firstTime := arrivals[firstID][hrs]
+			if t.Before(firstTime) || (t.Equal(firstTime) && id < firstID) {
+				first[hrs] = id
+			}
+		}
+	}
+	deliveries := make(map[int]int)
+	for _, id := range first {
+		deliveries[id]++
+	}
+	return deliveries
+}
+
+// estimateSigningLatencies returns the expected signing latency with each eligible cosigner as leader.
+// Cosigners without a connected sentry, or that can not reach enough peers for a signing round, are not eligible.
+func estimateSigningLatencies(
+	nodes []placementNode,
+	deliveries map[int]int,
+	threshold int,
+) map[int]time.Duration {
+	totalDeliveries := 0
+	for _, count := range deliveries {
+		totalDeliveries += count
+	}
+
+	estimates := make(map[int]time.Duration)
+	for _, node := range nodes {
+		if node.Sentries == 0 {
+			continue
+		}
+
+		latencies := make([]time.Duration, 0, len(node.Latencies))
+		for _, latency := range node.Latencies {
+			latencies = append(latencies, latency)
+		}
+		if len(latencies) < threshold-1 {
+			continue
+		}
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+
+		// ephemeral secret parts and signature parts are two round-trips to the threshold peers
+		var estimate time.Duration
+		if threshold > 1 {
+			estimate = 2 * latencies[threshold-2]
+		}
+
+		// sign requests first delivered to another cosigner are forwarded to the leader
+		eligible := true
+		for id, count := range deliveries {
+			if id == node.ID {
+				continue
+			}
+			latency, ok := node.Latencies[id]
+			if !ok {
+				eligible = false
+				break
+			}
+			estimate += time.Duration(int64(latency) * int64(count) / int64(totalDeliveries))
+		}
+		if eligible {
+			estimates[node.ID] = estimate
+		}
+	}
+	return estimates
+}
+
+// evaluate returns the share ID of the cosigner that leadership should be transferred to, if any.
+// A cosigner must be clearly faster than the leader for leaderPlacementRounds consecutive
+// evaluations, and no leadership change may have happened within the cooldown.
+func (lp *leaderPlacement) evaluate(leaderID int, estimates map[int]time.Duration, now time.Time) (int, bool) {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+
+	best, found := 0, false
+	for id, estimate := range estimates {
+		if id == leaderID {
+			continue
+		}
+		if !found || estimate < estimates[best] || (estimate == estimates[best] && id < best) {
+			best, found = id, true
+		}
+	}
+
+	leaderEstimate, leaderEligible := estimates[leaderID]
+	clearlyFaster := found && (!leaderEligible ||
+		(leaderEstimate-estimates[best] > leaderPlacementMinGain &&
+			float64(leaderEstimate-estimates[best]) > leaderPlacementMinGainRatio*float64(leaderEstimate)))
+	if !clearlyFaster {
+		lp.candidate, lp.candidateRounds = 0, 0
+		return 0, false
+	}
+
+	if lp.candidate != best {
+		lp.candidate, lp.candidateRounds = best, 0
+	}
+	lp.candidateRounds++
+	if lp.candidateRounds < leaderPlacementRounds || now.Sub(lp.lastChange) < leaderPlacementCooldown {
+		return 0, false
+	}
+
+	lp.candidate, lp.candidateRounds = 0, 0
+	lp.lastChange = now
+	return best, true
+}
+
+// observeLeadership starts the cooldown when this cosigner becomes the leader.
+// Returns whether this cosigner is the leader.
+func (lp *leaderPlacement) observeLeadership(isLeader bool, now time.Time) bool {
+	lp.mu.Lock()
+	defer lp.mu.Unlock()
+	if isLeader && !lp.wasLeader {
+		lp.lastChange = now
+		lp.candidate, lp.candidateRounds = 0, 0
+	}
+	lp.wasLeader = isLeader
+	return isLeader
+}
+
+// recordSignRequest records the arrival of a sign request from our sentries,
+// if automatic leader placement is enabled.
+func (s *RaftStore) recordSignRequest(hrs HRSKey) {
+	if s.leaderPlacement == nil {
+		return
+	}
+	s.leaderPlacement.recordArrival(hrs, time.Now())
+}
+
+// signRequestArrivals returns the recent arrivals of sign requests from our sentries for GetStatus,
+// if automatic leader placement is enabled.
+func (s *RaftStore) signRequestArrivals() []*proto.HRST {
+	if s.leaderPlacement == nil {
+		return nil
+	}
+	return arrivalsToProto(s.leaderPlacement.recentArrivals())
+}
+
+// runLeaderPlacement periodically moves the leadership to the cosigner that is expected to sign the fastest.
+func (s *RaftStore) runLeaderPlacement() {
+	ticker := time.NewTicker(leaderPlacementInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-s.Quit():
+			return
+		case <-ticker.C:
+			if !s.leaderPlacement.observeLeadership(s.raft.State() == raft.Leader, time.Now()) {
+				continue
+			}
+			s.placeLeader()
+		}
+	}
+}
+
+func (s *RaftStore) placeLeader() {
+	ourID := s.cosigner.GetID()
+	peers := s.getPeers()
+
+	nodes := make([]placementNode, len(peers)+1)
+	arrivals := make([]map[HRSKey]time.Time, len(peers)+1)
+	arrivals[0] = s.leaderPlacement.recentArrivals()
+	nodes[0] = placementNode{
+		ID:        ourID,
+		Address:   s.RaftBind,
+		Sentries:  getConnectedSentries(),
+		Latencies: placementLatencies(pingPeers(peers)),
+	}
+
+	var wg sync.WaitGroup
+	for i, peer := range peers {
+		wg.Add(1)
+		go func(i int, peer Cosigner) {
+			defer wg.Done()
+			node := placementNode{ID: peer.GetID(), Address: peer.GetAddress()}
+			status, err := NewRemoteCosigner(peer.GetID(), peer.GetAddress()).GetStatus()
+			if err != nil {
+				s.logger.Debug("Leader placement failed to get cosigner status", "id", peer.GetID(), "error", err)
+			} else {
+				node.Sentries = int(status.Sentries)
+				node.Latencies = placementLatencies(status.Peers)
+				arrivals[i+1] = arrivalsFromProto(status.SignRequestArrivals)
+			}
+			nodes[i+1] = node
+		}(i, peer)
+	}
+	wg.Wait()
+
+	// arrival times of different cosigners are compared by their clocks, the clock skew is monitored by GetStatus
+	nodeArrivals := make(map[int]map[HRSKey]time.Time)
+	for i, node := range nodes {
+		if arrivals[i] != nil {
+			nodeArrivals[node.ID] = arrivals[i]
+		}
+	}
+	estimates := estimateSigningLatencies(nodes, firstDeliveries(nodeArrivals), int(s.cosigner.threshold))
+
+	// never move the leadership to a lower priority cosigner, it would step down again
+	for id := range estimates {
+		if s.leaderPriority(id) < s.ourLeaderPriority() {
+			delete(estimates, id)
+		}
+	}
+	target, ok := s.leaderPlacement.evaluate(ourID, estimates, time.Now())
+	if !ok {
+		return
+	}
+
+	for _, node := range nodes {
+		if node.ID != target {
+			continue
+		}
+		s.logger.Info("Transferring leadership to cosigner expected to sign faster",
+			"id", target,
+			"expected_latency", estimates[target],
+			"our_expected_latency", estimates[ourID],
+		)
+		err := s.raft.LeadershipTransferToServer(
+			raft.ServerID(fmt.Sprint(target)),
+			raft.ServerAddress(p2pURLToRaftAddress(node.Address)),
+		).Error()
+		if err != nil {
+			s.logger.Error("Leader placement failed to transfer leadership", "id", target, "error", err)
+			return
+		}
+		totalLeaderPlacementTransfers.Inc()
+		return
+	}
+}
+
+func placementLatencies(peers []*proto.PeerLatency) map[int]time.Duration {
+	latencies := make(map[int]time.Duration)
+	for _, peer := range peers {
+		if peer.Error == "" {
+			latencies[int(peer.ShareID)] = time.Duration(peer.Latency)
+		}
+	}
+	return latencies
+}
@@ -0,0 +1,107 @@
+package signer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEstimateSigningLatencies(t *testing.T) {
+	nodes := []placementNode{
+		{ID: 1, Sentries: 1, Latencies: map[int]time.Duration{2: 40 * time.Millisecond, 3: 50 * time.Millisecond}},
+		{ID: 2, Sentries: 2, Latencies: map[int]time.Duration{1: 40 * time.Millisecond, 3: 10 * time.Millisecond}},
+		// no sentry connected
+		{ID: 3, Sentries: 0, Latencies: map[int]time.Duration{1: 50 * time.Millisecond, 2: 10 * time.Millisecond}},
+	}
+
+	// cosigner 2 delivers half of the sign requests first, cosigner 3 the other half
+	estimates := estimateSigningLatencies(nodes, map[int]int{2: 5, 3: 5}, 2)
+
+	require.Len(t, estimates, 2)
+	require.Equal(t, 2*40*time.Millisecond+(40+50)*time.Millisecond/2, estimates[1])
+	require.Equal(t, 2*10*time.Millisecond+10*time.Millisecond/2, estimates[2])
+
+	// cosigner 1 can not reach cosigner 3, which delivers sign requests
+	nodes[0].Latencies = map[int]time.Duration{2: 40 * time.Millisecond}
+	estimates = estimateSigningLatencies(nodes, map[int]int{3: 1}, 2)
+	require.Len(t, estimates, 1)
+	require.Contains(t, estimates, 2)
+}
+
+func TestLeaderPlacementEvaluate(t *testing.T) {
+	lp := newLeaderPlacement()
+	now := time.Now()
+
+	slower := map[int]time.Duration{1: 20 * time.Millisecond, 2: 18 * time.Millisecond}
+	faster := map[int]time.Duration{1: 100 * time.Millisecond, 2: 20 * time.Millisecond, 3: 30 * time.Millisecond}
+
+	// not clearly faster
+	for i := 0; i < leaderPlacementRounds; i++ {
+		_, ok := lp.evaluate(1, slower, now)
+		require.False(t, ok)
+	}
+
+	// clearly faster, but not for enough consecutive rounds
+	for i := 0; i < leaderPlacementRounds-1; i++ {
+		_, ok := lp.evaluate(1, faster, now)
+		require.False(t, ok)
+	}
+	_, ok := lp.evaluate(1, slower, now)
+	require.False(t, ok)
+
+	for i := 0; i < leaderPlacementRounds-1; i++ {
+		_, ok := lp.evaluate(1, faster, now)
+		require.False(t, ok)
+	}
+	target, ok := lp.evaluate(1, faster, now)
+	require.True(t, ok)
+	require.Equal(t, 2, target)
+
+	// leadership came back within the cooldown
+	now = now.Add(time.Minute)
+	require.True(t, lp.observeLeadership(true, now))
+	for i := 0; i < leaderPlacementRounds; i++ {
+		_, ok := lp.evaluate(1, faster, now)
+		require.False(t, ok)
+	}
+
+	now = now.Add(leaderPlacementCooldown)
+	target, ok = lp.evaluate(1, faster, now)
+	require.True(t, ok)
+	require.Equal(t, 2, target)
+}
+
+func TestLeaderPlacementArrivalWindow(t *testing.T) {
+	lp := newLeaderPlacement()
+	start := time.Now()
+	for i := 0; i < leaderPlacementArrivalWindow; i++ {
+		lp.recordArrival(HRSKey{Height: int64(i)}, start)
+	}
+	// only the first arrival of an HRS is kept
+	lp.recordArrival(HRSKey{Height: 0}, start.Add(time.Second))
+	require.Equal(t, start, lp.recentArrivals()[HRSKey{Height: 0}])
+
+	lp.recordArrival(HRSKey{Height: leaderPlacementArrivalWindow}, start)
+	arrivals := lp.recentArrivals()
+	require.Len(t, arrivals, leaderPlacementArrivalWindow)
+	require.NotContains(t, arrivals, HRSKey{Height: 0})
+
+	hrs := HRSKey{Height: 1, Round: 2, Step: stepPrecommit}
+	decoded := arrivalsFromProto(arrivalsToProto(map[HRSKey]time.Time{hrs: start}))
+	require.True(t, start.Equal(decoded[hrs]))
+}
+
+func TestFirstDeliveries(t *testing.T) {
+	start := time.Now()
+	hrs1, hrs2, hrs3, hrs4 := HRSKey{Height: 1}, HRSKey{Height: 2}, HRSKey{Height: 3}, HRSKey{Height: 4}
+
+	deliveries := firstDeliveries(map[int]map[HRSKey]time.Time{
+		1: {hrs1: start.Add(2 * time.Millisecond), hrs2: start, hrs3: start},
+		2: {hrs1: start, hrs2: start.Add(time.Millisecond)},
+		// a request only delivered to one cosigner is counted for it
+		3: {hrs1: start.Add(time.Millisecond), hrs3: start, hrs4: start.Add(time.Second)},
+	})
+	// the tie for hrs3 goes to the lower share ID
+	require.Equal(t, map[int]int{1: 2, 2: 1, 3: 1}, deliveries)
+}
@@ -0,0 +1,141 @@
+package signer
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/raft"
+	proto "github.com/strangelove-ventures/horcrux/signer/proto"
+)
+
+const (
+	// how often the leader checks whether a higher priority cosigner is available
+	leaderPriorityInterval = 10 * time.Second
+
+	// number of consecutive checks a higher priority cosigner must be healthy before stepping down
+	leaderPriorityRounds = 2
+)
+
+// leaderPriority returns the configured leader priority of the cosigner with the given share ID
+func (s *RaftStore) leaderPriority(id int) int {
+	return s.LeaderPriorities[id]
+}
+
+// ourLeaderPriority returns the configured leader priority of this cosigner
+func (s *RaftStore) ourLeaderPriority() int {
+	if s.isWitness() {
+		return 0
+	}
+	return s.leaderPriority(s.cosigner.GetID())
+}
+
+// leaderPriorityRank returns the number of distinct leader priorities higher than ours.
+// The election timeout is scaled by the rank, so that lower priority cosigners only campaign
+// when no higher priority cosigner has become leader in time.
+func (s *RaftStore) leaderPriorityRank() int {
+	ours := s.ourLeaderPriority()
+	higher := make(map[int]bool)
+	for _, priority := range s.LeaderPriorities {
+		if priority > ours {
+			higher[priority] = true
+		}
+	}
+	return len(higher)
+}
+
+// peersByLeaderPriority returns the peers sorted by descending leader priority, then by share ID
+func (s *RaftStore) peersByLeaderPriority() []Cosigner {
+	peers := append([]Cosigner{}, s.getPeers()...)
+	sort.SliceStable(peers, func(i, j int) bool {
+		pi, pj := s.leaderPriority(peers[i].GetID()), s.leaderPriority(peers[j].GetID())
+		if pi != pj {
+			return pi > pj
+		}
+		return peers[i].GetID() < peers[j].GetID()
+	})
+	return peers
+}
+
+// runLeaderPriority steps down as leader whenever a higher priority cosigner is healthy,
+// checking right after being elected and periodically after that.
+func (s *RaftStore) runLeaderPriority() {
+	ticker := time.NewTicker(leaderPriorityInterval)
+	defer ticker.Stop()
+
+	var candidate, rounds int
+	for {
+		select {
+		case <-s.Quit():
+			return
+		case isLeader := <-s.raft.LeaderCh():
+			candidate, rounds = 0, 0
+			if !isLeader {
+				continue
+			}
+		case <-ticker.C:
+			if s.raft.State() != raft.Leader {
+				continue
+			}
+		}
+
+		target := s.higherPriorityPeer()
+		if target == nil {
+			candidate, rounds = 0, 0
+			continue
+		}
+		if target.GetID() != candidate {
+			candidate, rounds = target.GetID(), 0
+		}
+		rounds++
+		if rounds < leaderPriorityRounds {
+			continue
+		}
+		candidate, rounds = 0, 0
+
+		s.logger.Info("Stepping down as raft leader for higher priority cosigner",
+			"id", target.GetID(),
+			"priority", s.leaderPriority(target.GetID()),
+			"our_priority", s.ourLeaderPriority(),
+		)
+		err := s.raft.LeadershipTransferToServer(
+			raft.ServerID(fmt.Sprint(target.GetID())),
+			raft.ServerAddress(p2pURLToRaftAddress(target.GetAddress())),
+		).Error()
+		if err != nil {
+			s.logger.Error("Failed to transfer leadership to higher priority cosigner", "id", target.GetID(), "error", err)
+			continue
+		}
+		totalLeaderPriorityTransfers.Inc()
+	}
+}
+
+// higherPriorityPeer returns the healthy peer with the highest leader priority above ours, if any.
+// A peer is healthy if it is a raft follower in contact with the leader and has a connected sentry.
+func (s *RaftStore) higherPriorityPeer() Cosigner {
+	ours := s.ourLeaderPriority()
+	for _, peer := range s.peersByLeaderPriority() {
+		if s.leaderPriority(peer.GetID()) <= ours {
+			return nil
+		}
+		status, err := NewRemoteCosigner(peer.GetID(), peer.GetAddress()).GetStatus()
+		if err != nil {
+			s.logger.Debug("Higher priority cosigner is not reachable", "id", peer.GetID(), "error", err)
+			continue
+		}
+		if !s.isHealthyFollower(status) {
+			s.logger.Debug("Higher priority cosigner is not healthy", "id", peer.GetID(),
+				"raft_state", status.RaftState, "sentries", status.Sentries)
+			continue
+		}
+		return peer
+	}
+	return nil
+}
+
+// isHealthyFollower returns true if the cosigner status is that of a raft follower
+// in contact with the leader that has a connected sentry.
+func (s *RaftStore) isHealthyFollower(status *proto.CosignerGRPCGetStatusResponse) bool {
+	return status.RaftState == raft.Follower.String() && status.LastContact >= 0 &&
+		time.Duration(status.LastContact) <= s.RaftTimeout && status.Sentries > 0
+}
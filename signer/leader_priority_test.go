@@ -0,0 +1,32 @@
+package signer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaderPriorityRank(t *testing.T) {
+	s := &RaftStore{
+		NodeID:   "3",
+		cosigner: &LocalCosigner{key: CosignerKey{ID: 3}},
+		Peers: []Cosigner{
+			NewRemoteCosigner(1, "tcp://cosigner-1:2222"),
+			NewRemoteCosigner(2, "tcp://cosigner-2:2222"),
+			NewRemoteCosigner(4, "tcp://cosigner-4:2222"),
+		},
+	}
+	require.Equal(t, 0, s.leaderPriorityRank())
+
+	s.LeaderPriorities = map[int]int{1: 2, 2: 2, 3: 0, 4: 1}
+	require.Equal(t, 2, s.leaderPriorityRank())
+
+	ids := []int{}
+	for _, peer := range s.peersByLeaderPriority() {
+		ids = append(ids, peer.GetID())
+	}
+	require.Equal(t, []int{1, 2, 4}, ids)
+
+	s.LeaderPriorities[3] = 2
+	require.Equal(t, 0, s.leaderPriorityRank())
+}
@@ -69,6 +69,21 @@ type LocalCosignerConfig struct {
 	RaftAddress string
 	Total       uint8
 	Threshold   uint8
+
+	// chain ID that sign bytes must be for, not checked if empty
+	ChainID string
+
+	// maximum time the timestamp in sign bytes may be ahead of the local clock, not checked if zero
+	MaxClockSkew time.Duration
+
+	// records the signatures with the key share, may be nil
+	AuditLog *AuditLog
+
+	// refuses to deal or sign with the key share while paused by an operator, may be nil
+	Pause *SigningPause
+
+	// switches the chain ID at a chain upgrade, may be nil
+	Upgrade *ChainUpgrade
 }
 
 type PeerMetadata struct {
@@ -103,9 +118,27 @@ type LocalCosigner struct {
 
 	// Height, Round, Step -> metadata
 	hrsMeta map[HRSTKey]HrsMetadata
-	peers   map[int]CosignerPeer
+
+	// peers may change at runtime through raft membership changes
+	peers      map[int]CosignerPeer
+	peersMutex sync.RWMutex
 
 	address string
+
+	// chain ID that sign bytes must be for, not checked if empty
+	chainID string
+
+	// maximum time the timestamp in sign bytes may be ahead of the local clock, not checked if zero
+	maxClockSkew time.Duration
+
+	// records the signatures with the key share, may be nil
+	auditLog *AuditLog
+
+	// refuses to deal or sign with the key share while paused by an operator, may be nil
+	pause *SigningPause
+
+	// switches the chain ID at a chain upgrade, may be nil
+	upgrade *ChainUpgrade
 }
 
 func (cosigner *LocalCosigner) SaveLastSignedState(signState SignStateConsensus) error {
@@ -122,6 +155,11 @@ func NewLocalCosigner(cfg LocalCosignerConfig) *LocalCosigner {
 		total:         cfg.Total,
 		threshold:     cfg.Threshold,
 		address:       cfg.Address,
+		chainID:       cfg.ChainID,
+		maxClockSkew:  cfg.MaxClockSkew,
+		auditLog:      cfg.AuditLog,
+		pause:         cfg.Pause,
+		upgrade:       cfg.Upgrade,
 	}
 
 	for _, peer := range cfg.Peers {
@@ -140,6 +178,23 @@ func NewLocalCosigner(cfg LocalCosignerConfig) *LocalCosigner {
 	return cosigner
 }
 
+// getChainID returns the chain ID that sign bytes must be for, which changes at a chain upgrade
+func (cosigner *LocalCosigner) getChainID() string {
+	if chainID := cosigner.upgrade.ChainID(); chainID != "" {
+		return chainID
+	}
+	return cosigner.chainID
+}
+
+// switchChain starts the sign state of a new chain at the height.
+// The ephemeral secrets dealt for the old chain are dropped so that they are never used twice.
+func (cosigner *LocalCosigner) switchChain(filePath string, height int64) error {
+	cosigner.lastSignStateMutex.Lock()
+	defer cosigner.lastSignStateMutex.Unlock()
+	cosigner.hrsMeta = make(map[HRSTKey]HrsMetadata)
+	return cosigner.lastSignState.switchChain(filePath, height, nil)
+}
+
 // GetID returns the id of the cosigner
 // Implements Cosigner interface
 func (cosigner *LocalCosigner) GetID() int {
@@ -152,6 +207,61 @@ func (cosigner *LocalCosigner) GetAddress() string {
 	return cosigner.address
 }
 
+// UpdatePeers replaces the cosigners that we exchange ephemeral secret parts with.
+// The RSA public keys of the peers are taken from our cosigner key.
+func (cosigner *LocalCosigner) UpdatePeers(ids []int) error {
+	peers := make(map[int]CosignerPeer)
+	for _, id := range ids {
+		if id < 1 || id > len(cosigner.key.CosignerKeys) {
+			return fmt.Errorf("no RSA public key for cosigner ID %d", id)
+		}
+		peers[id] = CosignerPeer{
+			ID:        id,
+			PublicKey: *cosigner.key.CosignerKeys[id-1],
+		}
+	}
+	if _, ok := peers[cosigner.GetID()]; !ok {
+		// we always need ourselves as a peer to handle GetEphemeralSecretParts requests
+		peers[cosigner.GetID()] = CosignerPeer{
+			ID:        cosigner.GetID(),
+			PublicKey: cosigner.rsaKey.PublicKey,
+		}
+	}
+
+	cosigner.peersMutex.Lock()
+	defer cosigner.peersMutex.Unlock()
+	cosigner.peers = peers
+	return nil
+}
+
+func (cosigner *LocalCosigner) getPeer(id int) (CosignerPeer, bool) {
+	cosigner.peersMutex.RLock()
+	defer cosigner.peersMutex.RUnlock()
+	peer, ok := cosigner.peers[id]
+	return peer, ok
+}
+
+func (cosigner *LocalCosigner) getPeers() []CosignerPeer {
+	cosigner.peersMutex.RLock()
+	defer cosigner.peersMutex.RUnlock()
+	peers := make([]CosignerPeer, 0, len(cosigner.peers))
+	for _, peer := range cosigner.peers {
+		peers = append(peers, peer)
+	}
+	return peers
+}
+
+// lastSignedHRS returns the height, round and step last signed with our share
+func (cosigner *LocalCosigner) lastSignedHRS() HRSTKey {
+	cosigner.lastSignStateMutex.Lock()
+	defer cosigner.lastSignStateMutex.Unlock()
+	return HRSTKey{
+		Height: cosigner.lastSignState.Height,
+		Round:  cosigner.lastSignState.Round,
+		Step:   cosigner.lastSignState.Step,
+	}
+}
+
 // Sign the sign request using the cosigner's share
 // Return the signed bytes or an error
 // Implements Cosigner interface
@@ -293,12 +403,19 @@ func (cosigner *LocalCosigner) dealShares(req CosignerGetEphemeralSecretPartRequ
 
 func (cosigner *LocalCosigner) GetEphemeralSecretParts(
 	hrst HRSTKey) (*CosignerEphemeralSecretPartsResponse, error) {
+	if err := cosigner.pause.check(hrst.Height); err != nil {
+		return nil, err
+	}
+	if err := cosigner.upgrade.check(cosigner.getChainID(), hrst.Height); err != nil {
+		return nil, err
+	}
 	metricsTimeKeeper.SetPreviousLocalEphemeralShare(time.Now())
 
+	peers := cosigner.getPeers()
 	res := &CosignerEphemeralSecretPartsResponse{
-		EncryptedSecrets: make([]CosignerEphemeralSecretPart, 0, len(cosigner.peers)-1),
+		EncryptedSecrets: make([]CosignerEphemeralSecretPart, 0, len(peers)-1),
 	}
-	for _, peer := range cosigner.peers {
+	for _, peer := range peers {
 		if peer.ID == cosigner.GetID() {
 			continue
 		}
@@ -361,7 +478,7 @@ func (cosigner *LocalCosigner) getEphemeralSecretPart(
 	meta.Peers[cosigner.key.ID-1].EphemeralSecretPublicKey = ourEphPublicKey
 
 	// grab the peer info for the ID being requested
-	peer, ok := cosigner.peers[req.ID]
+	peer, ok := cosigner.getPeer(req.ID)
 	if !ok {
 		return res, errors.New("unknown peer ID")
 	}
@@ -420,7 +537,7 @@ func (cosigner *LocalCosigner) setEphemeralSecretPart(req CosignerSetEphemeralSe
 		}
 
 		digest := sha256.Sum256(digestBytes)
-		peer, ok := cosigner.peers[req.SourceID]
+		peer, ok := cosigner.getPeer(req.SourceID)
 
 		if !ok {
 			return fmt.Errorf("unknown cosigner: %d", req.SourceID)
@@ -475,6 +592,23 @@ func (cosigner *LocalCosigner) setEphemeralSecretPart(req CosignerSetEphemeralSe
 
 func (cosigner *LocalCosigner) SetEphemeralSecretPartsAndSign(
 	req CosignerSetEphemeralSecretPartsAndSignRequest) (*CosignerSignResponse, error) {
+	start := time.Now()
+
+	if err := cosigner.pause.check(req.HRST.Height); err != nil {
+		return nil, err
+	}
+	if err := cosigner.upgrade.check(cosigner.getChainID(), req.HRST.Height); err != nil {
+		return nil, err
+	}
+
+	// the leader is not trusted to only ask for signatures of what the sentries requested
+	if err := ValidateSignBytes(cosigner.getChainID(), req.HRST, req.SignBytes); err != nil {
+		return nil, err
+	}
+	if err := checkClockSkew(cosigner.maxClockSkew, time.Unix(0, req.HRST.Timestamp)); err != nil {
+		return nil, err
+	}
+
 	for _, secretPart := range req.EncryptedSecrets {
 		err := cosigner.setEphemeralSecretPart(CosignerSetEphemeralSecretPartRequest{
 			SourceID:                       secretPart.SourceID,
@@ -492,5 +626,22 @@ func (cosigner *LocalCosigner) SetEphemeralSecretPartsAndSign(
 	}
 
 	res, err := cosigner.sign(CosignerSignRequest{req.SignBytes})
-	return &res, err
+	if err != nil {
+		return &res, err
+	}
+
+	// the cosigners taking part are the sources of the ephemeral secret parts
+	cosigners := []int{cosigner.GetID()}
+	for _, secretPart := range req.EncryptedSecrets {
+		if secretPart.SourceID != cosigner.GetID() {
+			cosigners = append(cosigners, secretPart.SourceID)
+		}
+	}
+	cosigner.auditLog.recordSignature(AuditRoleCosigner, req.SignBytes, cosigners, "", time.Since(start))
+	return &res, nil
+}
+
+// flushSignState synchronously persists the share sign state
+func (cosigner *LocalCosigner) flushSignState() {
+	cosigner.lastSignState.Flush(&cosigner.lastSignStateMutex)
 }
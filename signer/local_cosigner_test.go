@@ -3,7 +3,6 @@ package signer
 import (
 	"crypto/rand"
 	"crypto/rsa"
-	"os"
 	"testing"
 	"time"
 
@@ -46,6 +45,56 @@ func TestLocalCosignerGetID(t *testing.T) {
 	require.Equal(t, cosigner.GetID(), 1)
 }
 
+func TestLocalCosignerUpdatePeers(t *testing.T) {
+	bitSize := 2048
+	rsaKey1, err := rsa.GenerateKey(rand.Reader, bitSize)
+	require.NoError(t, err)
+	rsaKey2, err := rsa.GenerateKey(rand.Reader, bitSize)
+	require.NoError(t, err)
+	rsaKey3, err := rsa.GenerateKey(rand.Reader, bitSize)
+	require.NoError(t, err)
+
+	key := CosignerKey{
+		PubKey:       tmCryptoEd25519.PubKey{},
+		ShareKey:     []byte{},
+		ID:           1,
+		CosignerKeys: []*rsa.PublicKey{&rsaKey1.PublicKey, &rsaKey2.PublicKey, &rsaKey3.PublicKey},
+	}
+
+	cosigner := NewLocalCosigner(LocalCosignerConfig{
+		CosignerKey: key,
+		SignState:   &SignState{},
+		RsaKey:      *rsaKey1,
+		Peers: []CosignerPeer{{
+			ID:        1,
+			PublicKey: rsaKey1.PublicKey,
+		}, {
+			ID:        2,
+			PublicKey: rsaKey2.PublicKey,
+		}},
+		Total:     3,
+		Threshold: 2,
+	})
+
+	// ourselves are kept as a peer even when not part of the update
+	require.NoError(t, cosigner.UpdatePeers([]int{3}))
+	require.Len(t, cosigner.getPeers(), 2)
+
+	_, ok := cosigner.getPeer(2)
+	require.False(t, ok)
+
+	peer, ok := cosigner.getPeer(3)
+	require.True(t, ok)
+	require.Equal(t, rsaKey3.PublicKey, peer.PublicKey)
+
+	_, ok = cosigner.getPeer(1)
+	require.True(t, ok)
+
+	// no RSA public key for share ID 4
+	require.Error(t, cosigner.UpdatePeers([]int{2, 4}))
+	require.Len(t, cosigner.getPeers(), 2)
+}
+
 func TestLocalCosignerSign2of2(t *testing.T) {
 	// Test signing with a 2 of 2
 
@@ -79,11 +128,9 @@ func TestLocalCosignerSign2of2(t *testing.T) {
 		ID:       1,
 	}
 
-	stateFile1, err := os.CreateTemp("", "state1.json")
-	require.NoError(t, err)
-	defer os.Remove(stateFile1.Name())
+	stateFile1 := testSignStateFile(t, "state1.json")
 
-	signState1, err := LoadOrCreateSignState(stateFile1.Name())
+	signState1, err := LoadOrCreateSignState(stateFile1)
 	require.NoError(t, err)
 
 	key2 := CosignerKey{
@@ -92,10 +139,8 @@ func TestLocalCosignerSign2of2(t *testing.T) {
 		ID:       2,
 	}
 
-	stateFile2, err := os.CreateTemp("", "state2.json")
-	require.NoError(t, err)
-	defer os.Remove(stateFile2.Name())
-	signState2, err := LoadOrCreateSignState(stateFile2.Name())
+	stateFile2 := testSignStateFile(t, "state2.json")
+	signState2, err := LoadOrCreateSignState(stateFile2)
 	require.NoError(t, err)
 
 	config1 := LocalCosignerConfig{
@@ -202,11 +247,9 @@ func TestLocalCosignerWatermark(t *testing.T) {
 			ID:       1,
 		}
 
-		stateFile1, err := os.CreateTemp("", "state1.json")
-		require.NoError(t, err)
-		defer os.Remove(stateFile1.Name())
+		stateFile1 := testSignStateFile(t, "state1.json")
 
-		signState1, err := LoadOrCreateSignState(stateFile1.Name())
+		signState1, err := LoadOrCreateSignState(stateFile1)
 
 		cosigner1 := NewLocalCosigner(key1, &signState1)
 
@@ -172,10 +172,41 @@ var (
 		[]string{"peerid"},
 	)
 
+	peerClockSkew = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "signer_peer_clock_skew_seconds",
+			Help: "Last Measured Clock Skew of a Peer Cosigner (Positive if the Peer Clock is Ahead)",
+		},
+		[]string{"peerid"},
+	)
+	totalPeerClockSkewWarnings = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "signer_total_peer_clock_skew_warnings",
+			Help: "Total Times the Clock Skew of a Peer Cosigner was Above the Limit",
+		},
+		[]string{"peerid"},
+	)
+	totalClockSkewRejections = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "signer_total_clock_skew_rejections",
+		Help: "Total Times Signing was Refused for a Timestamp Too Far Ahead of the Local Clock",
+	})
+
 	sentryConnectTries = promauto.NewGauge(prometheus.GaugeOpts{
 		Name: "signer_sentry_connect_tries",
 		Help: "Consecutive Number of times sentry TCP connect has been tried (High count may indicate validator restarts)",
 	})
+	sentriesConnected = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "signer_sentries_connected",
+		Help: "Number of sentries currently connected",
+	})
+	startupGatingSeconds = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "signer_startup_gating_seconds",
+		Help: "Seconds waited on startup for the cosigner to be ready before connecting to sentries",
+	})
+	totalStartupGatingTimeouts = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "signer_total_startup_gating_timeouts",
+		Help: "Total Times the Cosigner Connected to Sentries Before it was Ready to Sign",
+	})
 	totalSentryConnectTries = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "signer_total_sentry_connect_tries",
 		Help: "Total Number of times sentry TCP connect has been tried (High count may indicate validator restarts)",
@@ -198,10 +229,76 @@ var (
 		Name: "signer_total_raft_not_leader",
 		Help: "Total Times Signer is NOT Raft Leader (Proxy signing to Raft Leader)",
 	})
+	totalFollowerCacheHits = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "signer_total_follower_cache_hits",
+		Help: "Total Times Signer is NOT Raft Leader and answered the request from the LSS cache",
+	})
+	totalFollowerCacheRejections = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "signer_total_follower_cache_rejections",
+		Help: "Total Times Signer is NOT Raft Leader and refused a regression or conflicting request from the LSS cache",
+	})
+	totalLeaderlessSignRounds = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "signer_total_leaderless_sign_rounds",
+		Help: "Total Times Signer Coordinated a Signing Round in Leaderless Mode",
+	})
 	totalRaftLeaderElectiontimeout = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "signer_total_raft_leader_election_timeout",
 		Help: "Total Times Raft Leader Failed Election (Lacking Peers)",
 	})
+	totalLeaderPlacementTransfers = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "signer_total_leader_placement_transfers",
+		Help: "Total Times Leadership was Transferred to a Cosigner Expected to Sign Faster",
+	})
+	totalLeaderPriorityTransfers = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "signer_total_leader_priority_transfers",
+		Help: "Total Times Leadership was Transferred to a Higher Priority Cosigner",
+	})
+
+	totalConflictingRequests = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "signer_total_conflicting_requests",
+			Help: "Total Sign Requests Refused Because they Conflict with an Already Signed Block " +
+				"(Possible Double Sign Attempt)",
+		},
+		[]string{"sentry"},
+	)
+	lastConflictingRequestHeight = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "signer_last_conflicting_request_height",
+		Help: "Height of the Last Sign Request Refused Because it Conflicts with an Already Signed Block",
+	})
+
+	totalAuditLogErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "signer_total_audit_log_errors",
+		Help: "Total Signatures that Could not be Written to the Audit Log",
+	})
+
+	signingPaused = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "signer_signing_paused",
+		Help: "Signing is Paused by an Operator (1) or Not (0)",
+	})
+	totalPausedRejections = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "signer_total_paused_rejections",
+		Help: "Total Sign Requests Refused while Signing is Paused",
+	})
+
+	totalAlertsFired = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "signer_total_alerts_fired",
+			Help: "Total Alerts Sent to the Notifiers",
+		},
+		[]string{"event"},
+	)
+	totalAlertsSuppressed = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "signer_total_alerts_suppressed",
+			Help: "Total Alerts Not Sent Because of the Rate Limit",
+		},
+		[]string{"event"},
+	)
+	totalAlertNotifyErrors = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "signer_total_alert_notify_errors",
+		Help: "Total Times Sending an Alert to a Notifier Failed",
+	})
 
 	totalInvalidSignature = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "signer_error_total_invalid_signatures",
@@ -0,0 +1,198 @@
+package signer
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	tmJson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/tempfile"
+)
+
+// PausedError is returned for sign requests while signing is paused by an operator
+type PausedError struct {
+	msg string
+}
+
+func (e *PausedError) Error() string { return e.msg }
+
+func newPausedError(reason string, since time.Time) *PausedError {
+	msg := fmt.Sprintf("signing is paused since %s", since.UTC().Format(time.RFC3339))
+	if reason != "" {
+		msg += ": " + reason
+	}
+	return &PausedError{msg: msg}
+}
+
+func newClusterPausedError(cp ClusterPause) *PausedError {
+	msg := fmt.Sprintf("signing is paused on all cosigners since %s", cp.Since.UTC().Format(time.RFC3339))
+	if cp.Reason != "" {
+		msg += ": " + cp.Reason
+	}
+	if cp.ResumeHeight != 0 {
+		msg += fmt.Sprintf(", resuming at height %d", cp.ResumeHeight)
+	}
+	return &PausedError{msg: msg}
+}
+
+// ClusterPause is a pause of signing on all cosigners, replicated through raft
+// and persisted by each cosigner so that it survives restarts.
+type ClusterPause struct {
+	Paused bool      `json:"paused"`
+	Reason string    `json:"reason,omitempty"`
+	Since  time.Time `json:"since,omitempty"`
+
+	// signing resumes for blocks at or above this height, never if zero
+	ResumeHeight int64 `json:"resume_height,omitempty"`
+}
+
+// resumesAt returns true if the pause no longer applies to blocks at the height
+func (cp ClusterPause) resumesAt(height int64) bool {
+	return cp.ResumeHeight != 0 && height >= cp.ResumeHeight
+}
+
+// LoadClusterPause reads the cluster pause persisted by this cosigner.
+// Returns no pause if the file does not exist.
+func LoadClusterPause(filePath string) (ClusterPause, error) {
+	var cp ClusterPause
+	bz, err := os.ReadFile(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cp, nil
+		}
+		return cp, err
+	}
+	if err := tmJson.Unmarshal(bz, &cp); err != nil {
+		return cp, fmt.Errorf("error parsing cluster pause file %s: %w", filePath, err)
+	}
+	return cp, nil
+}
+
+func (cp ClusterPause) save(filePath string) error {
+	bz, err := tmJson.MarshalIndent(cp, "", "  ")
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(filePath, bz, 0600)
+}
+
+// SigningPause lets an operator stop this signer from signing without stopping the process,
+// i.e. to change the sign state while the cosigner keeps its raft membership.
+// The threshold validator and the local cosigner of a process share the same pause.
+// Signing is paused while either this cosigner or the whole cluster is paused,
+// or while this cosigner is not a member of the raft cluster.
+type SigningPause struct {
+	mu     sync.RWMutex
+	paused bool
+	reason string
+	since  time.Time
+
+	// apart from the pause of the operator, so that adding the cosigner back does not resume it
+	removed      bool
+	removedSince time.Time
+
+	cluster ClusterPause
+}
+
+// removedPauseReason is the reason of the pause while this cosigner is not a member of the raft cluster
+const removedPauseReason = "removed from the cluster"
+
+// Pause stops signing until Resume is called. Pausing again only updates the reason.
+func (p *SigningPause) Pause(reason string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.paused {
+		p.since = time.Now()
+	}
+	p.paused = true
+	p.reason = reason
+	p.updateMetrics()
+}
+
+// Resume allows signing again, unless the cluster is paused or this cosigner is not a member of it.
+// Returns false if signing was not paused by the operator.
+func (p *SigningPause) Resume() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	wasPaused := p.paused
+	p.paused = false
+	p.reason = ""
+	p.since = time.Time{}
+	p.updateMetrics()
+	return wasPaused
+}
+
+// SetRemoved pauses signing while this cosigner is not a member of the raft cluster,
+// and resumes it once it is a member again, unless the operator paused it.
+func (p *SigningPause) SetRemoved(removed bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if removed && !p.removed {
+		p.removedSince = time.Now()
+	} else if !removed {
+		p.removedSince = time.Time{}
+	}
+	p.removed = removed
+	p.updateMetrics()
+}
+
+// SetClusterPause sets the pause of all cosigners, as replicated through raft
+func (p *SigningPause) SetClusterPause(cp ClusterPause) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.cluster = cp
+	p.updateMetrics()
+}
+
+// updateMetrics must be called with p.mu held
+func (p *SigningPause) updateMetrics() {
+	if p.paused || p.removed || p.cluster.Paused {
+		signingPaused.Set(1)
+	} else {
+		signingPaused.Set(0)
+	}
+}
+
+// Paused returns whether signing is paused on this cosigner, with the reason and since when.
+// A pause of the operator is reported before the removal from the cluster.
+func (p *SigningPause) Paused() (paused bool, reason string, since time.Time) {
+	if p == nil {
+		return false, "", time.Time{}
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if !p.paused && p.removed {
+		return true, removedPauseReason, p.removedSince
+	}
+	return p.paused, p.reason, p.since
+}
+
+// ClusterPause returns the pause of all cosigners
+func (p *SigningPause) ClusterPause() ClusterPause {
+	if p == nil {
+		return ClusterPause{}
+	}
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.cluster
+}
+
+// isPaused returns true if signing is paused on this cosigner or on all cosigners
+func (p *SigningPause) isPaused() bool {
+	paused, _, _ := p.Paused()
+	return paused || p.ClusterPause().Paused
+}
+
+// check returns a PausedError if signing blocks at the height is paused. A nil pause never pauses.
+func (p *SigningPause) check(height int64) error {
+	if paused, reason, since := p.Paused(); paused {
+		totalPausedRejections.Inc()
+		return newPausedError(reason, since)
+	}
+	if cp := p.ClusterPause(); cp.Paused && !cp.resumesAt(height) {
+		totalPausedRejections.Inc()
+		return newClusterPausedError(cp)
+	}
+	return nil
+}
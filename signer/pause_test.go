@@ -0,0 +1,142 @@
+package signer
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmCryptoEd25519 "github.com/tendermint/tendermint/crypto/ed25519"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+)
+
+func TestSigningPause(t *testing.T) {
+	// no pause configured
+	var nilPause *SigningPause
+	require.NoError(t, nilPause.check(1))
+
+	pause := &SigningPause{}
+	require.NoError(t, pause.check(1))
+	require.False(t, pause.Resume())
+
+	pause.Pause("upgrade")
+	paused, reason, since := pause.Paused()
+	require.True(t, paused)
+	require.Equal(t, "upgrade", reason)
+	err := pause.check(1)
+	require.IsType(t, &PausedError{}, err)
+	require.Contains(t, err.Error(), "upgrade")
+
+	// pausing again keeps the time the pause started
+	pause.Pause("still upgrading")
+	_, reason, sinceAgain := pause.Paused()
+	require.Equal(t, "still upgrading", reason)
+	require.Equal(t, since, sinceAgain)
+
+	require.True(t, pause.Resume())
+	require.NoError(t, pause.check(1))
+}
+
+func TestClusterPause(t *testing.T) {
+	pause := &SigningPause{}
+	pause.SetClusterPause(ClusterPause{Paused: true, Reason: "chain upgrade", Since: time.Now(), ResumeHeight: 100})
+	require.True(t, pause.isPaused())
+
+	err := pause.check(99)
+	require.IsType(t, &PausedError{}, err)
+	require.Contains(t, err.Error(), "all cosigners")
+	require.Contains(t, err.Error(), "chain upgrade")
+	require.Contains(t, err.Error(), "resuming at height 100")
+	require.NoError(t, pause.check(100))
+
+	// resuming this cosigner does not resume the cluster
+	require.False(t, pause.Resume())
+	require.Error(t, pause.check(99))
+
+	pause.SetClusterPause(ClusterPause{})
+	require.False(t, pause.isPaused())
+	require.NoError(t, pause.check(99))
+}
+
+func TestLoadClusterPause(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "cluster_pause.json")
+
+	cp, err := LoadClusterPause(filePath)
+	require.NoError(t, err)
+	require.False(t, cp.Paused)
+
+	saved := ClusterPause{Paused: true, Reason: "chain upgrade", Since: time.Unix(1650000000, 0).UTC(), ResumeHeight: 100}
+	require.NoError(t, saved.save(filePath))
+	cp, err = LoadClusterPause(filePath)
+	require.NoError(t, err)
+	require.Equal(t, saved, cp)
+}
+
+func TestClusterPauseEvents(t *testing.T) {
+	privateKey := tmCryptoEd25519.GenPrivKey()
+	dir := t.TempDir()
+
+	signState, err := LoadOrCreateSignState(filepath.Join(dir, "state.json"))
+	require.NoError(t, err)
+	shareSignState, err := LoadOrCreateSignState(filepath.Join(dir, "share_state.json"))
+	require.NoError(t, err)
+
+	pause := &SigningPause{}
+	cosigner := NewLocalCosigner(LocalCosignerConfig{
+		CosignerKey: CosignerKey{PubKey: privateKey.PubKey(), ID: 1},
+		SignState:   &shareSignState,
+		Total:       2,
+		Threshold:   2,
+		Pause:       pause,
+	})
+	logger := tmlog.NewNopLogger()
+	validator := NewThresholdValidator(&ThresholdValidatorOpt{
+		Pubkey:    privateKey.PubKey(),
+		Threshold: 2,
+		SignState: signState,
+		Cosigner:  cosigner,
+		Logger:    logger,
+		Pause:     pause,
+	})
+
+	pauseFile := filepath.Join(dir, "cluster_pause.json")
+	f := &fsm{
+		logger:             logger,
+		cosigner:           cosigner,
+		thresholdValidator: validator,
+		Pause:              pause,
+		PauseFile:          pauseFile,
+	}
+
+	f.handlePauseEvent(`{"paused":true,"reason":"chain upgrade","since":"2022-04-15T05:20:00Z","resume_height":10}`)
+	require.Error(t, pause.check(9))
+
+	// persisted, so that a restart stays paused
+	cp, err := LoadClusterPause(pauseFile)
+	require.NoError(t, err)
+	require.True(t, cp.Paused)
+	require.Equal(t, int64(10), cp.ResumeHeight)
+
+	f.handleLSSEvent(`{"Height":9,"Round":0,"Step":2}`)
+	require.True(t, pause.ClusterPause().Paused)
+
+	// every cosigner resumes once the resume height has been signed
+	f.handleLSSEvent(`{"Height":10,"Round":0,"Step":1}`)
+	require.False(t, pause.ClusterPause().Paused)
+	cp, err = LoadClusterPause(pauseFile)
+	require.NoError(t, err)
+	require.False(t, cp.Paused)
+
+	f.handlePauseEvent(`{"paused":true}`)
+	require.Error(t, pause.check(100))
+	f.handlePauseEvent(`{"paused":false}`)
+	require.NoError(t, pause.check(100))
+
+	// the sign states are saved asynchronously
+	for _, file := range []string{filepath.Join(dir, "state.json"), filepath.Join(dir, "share_state.json")} {
+		require.Eventually(t, func() bool {
+			saved, err := LoadSignState(file)
+			return err == nil && saved.Height == 10
+		}, 5*time.Second, 10*time.Millisecond)
+	}
+}
@@ -0,0 +1,1558 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.6
+// source: signer/proto/admin_grpc_server.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AdminGRPCPauseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason       string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	Cluster      bool   `protobuf:"varint,2,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	ResumeHeight int64  `protobuf:"varint,3,opt,name=resumeHeight,proto3" json:"resumeHeight,omitempty"`
+}
+
+func (x *AdminGRPCPauseRequest) Reset() {
+	*x = AdminGRPCPauseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGRPCPauseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGRPCPauseRequest) ProtoMessage() {}
+
+func (x *AdminGRPCPauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGRPCPauseRequest.ProtoReflect.Descriptor instead.
+func (*AdminGRPCPauseRequest) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AdminGRPCPauseRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AdminGRPCPauseRequest) GetCluster() bool {
+	if x != nil {
+		return x.Cluster
+	}
+	return false
+}
+
+func (x *AdminGRPCPauseRequest) GetResumeHeight() int64 {
+	if x != nil {
+		return x.ResumeHeight
+	}
+	return 0
+}
+
+type AdminGRPCPauseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminGRPCPauseResponse) Reset() {
+	*x = AdminGRPCPauseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGRPCPauseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGRPCPauseResponse) ProtoMessage() {}
+
+func (x *AdminGRPCPauseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGRPCPauseResponse.ProtoReflect.Descriptor instead.
+func (*AdminGRPCPauseResponse) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{1}
+}
+
+type AdminGRPCResumeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cluster bool `protobuf:"varint,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+}
+
+func (x *AdminGRPCResumeRequest) Reset() {
+	*x = AdminGRPCResumeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGRPCResumeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGRPCResumeRequest) ProtoMessage() {}
+
+func (x *AdminGRPCResumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGRPCResumeRequest.ProtoReflect.Descriptor instead.
+func (*AdminGRPCResumeRequest) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AdminGRPCResumeRequest) GetCluster() bool {
+	if x != nil {
+		return x.Cluster
+	}
+	return false
+}
+
+type AdminGRPCResumeResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WasPaused bool `protobuf:"varint,1,opt,name=wasPaused,proto3" json:"wasPaused,omitempty"`
+}
+
+func (x *AdminGRPCResumeResponse) Reset() {
+	*x = AdminGRPCResumeResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGRPCResumeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGRPCResumeResponse) ProtoMessage() {}
+
+func (x *AdminGRPCResumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGRPCResumeResponse.ProtoReflect.Descriptor instead.
+func (*AdminGRPCResumeResponse) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AdminGRPCResumeResponse) GetWasPaused() bool {
+	if x != nil {
+		return x.WasPaused
+	}
+	return false
+}
+
+type AdminSignState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round     int64  `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Step      int32  `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	SignBytes []byte `protobuf:"bytes,4,opt,name=signBytes,proto3" json:"signBytes,omitempty"`
+}
+
+func (x *AdminSignState) Reset() {
+	*x = AdminSignState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSignState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSignState) ProtoMessage() {}
+
+func (x *AdminSignState) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSignState.ProtoReflect.Descriptor instead.
+func (*AdminSignState) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AdminSignState) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *AdminSignState) GetRound() int64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *AdminSignState) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *AdminSignState) GetSignBytes() []byte {
+	if x != nil {
+		return x.SignBytes
+	}
+	return nil
+}
+
+type AdminGRPCGetStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminGRPCGetStateRequest) Reset() {
+	*x = AdminGRPCGetStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGRPCGetStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGRPCGetStateRequest) ProtoMessage() {}
+
+func (x *AdminGRPCGetStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGRPCGetStateRequest.ProtoReflect.Descriptor instead.
+func (*AdminGRPCGetStateRequest) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{5}
+}
+
+type AdminGRPCGetStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainID            string          `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	PrivValState       *AdminSignState `protobuf:"bytes,2,opt,name=privValState,proto3" json:"privValState,omitempty"`
+	ShareState         *AdminSignState `protobuf:"bytes,3,opt,name=shareState,proto3" json:"shareState,omitempty"`
+	Paused             bool            `protobuf:"varint,4,opt,name=paused,proto3" json:"paused,omitempty"`
+	PauseReason        string          `protobuf:"bytes,5,opt,name=pauseReason,proto3" json:"pauseReason,omitempty"`
+	PausedSince        int64           `protobuf:"varint,6,opt,name=pausedSince,proto3" json:"pausedSince,omitempty"`
+	ClusterPaused      bool            `protobuf:"varint,7,opt,name=clusterPaused,proto3" json:"clusterPaused,omitempty"`
+	ClusterPauseReason string          `protobuf:"bytes,8,opt,name=clusterPauseReason,proto3" json:"clusterPauseReason,omitempty"`
+	ClusterPausedSince int64           `protobuf:"varint,9,opt,name=clusterPausedSince,proto3" json:"clusterPausedSince,omitempty"`
+	ResumeHeight       int64           `protobuf:"varint,10,opt,name=resumeHeight,proto3" json:"resumeHeight,omitempty"`
+	HaltHeight         int64           `protobuf:"varint,11,opt,name=haltHeight,proto3" json:"haltHeight,omitempty"`
+	NewChainID         string          `protobuf:"bytes,12,opt,name=newChainID,proto3" json:"newChainID,omitempty"`
+	StartHeight        int64           `protobuf:"varint,13,opt,name=startHeight,proto3" json:"startHeight,omitempty"`
+}
+
+func (x *AdminGRPCGetStateResponse) Reset() {
+	*x = AdminGRPCGetStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGRPCGetStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGRPCGetStateResponse) ProtoMessage() {}
+
+func (x *AdminGRPCGetStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGRPCGetStateResponse.ProtoReflect.Descriptor instead.
+func (*AdminGRPCGetStateResponse) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AdminGRPCGetStateResponse) GetChainID() string {
+	if x != nil {
+		return x.ChainID
+	}
+	return ""
+}
+
+func (x *AdminGRPCGetStateResponse) GetPrivValState() *AdminSignState {
+	if x != nil {
+		return x.PrivValState
+	}
+	return nil
+}
+
+func (x *AdminGRPCGetStateResponse) GetShareState() *AdminSignState {
+	if x != nil {
+		return x.ShareState
+	}
+	return nil
+}
+
+func (x *AdminGRPCGetStateResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *AdminGRPCGetStateResponse) GetPauseReason() string {
+	if x != nil {
+		return x.PauseReason
+	}
+	return ""
+}
+
+func (x *AdminGRPCGetStateResponse) GetPausedSince() int64 {
+	if x != nil {
+		return x.PausedSince
+	}
+	return 0
+}
+
+func (x *AdminGRPCGetStateResponse) GetClusterPaused() bool {
+	if x != nil {
+		return x.ClusterPaused
+	}
+	return false
+}
+
+func (x *AdminGRPCGetStateResponse) GetClusterPauseReason() string {
+	if x != nil {
+		return x.ClusterPauseReason
+	}
+	return ""
+}
+
+func (x *AdminGRPCGetStateResponse) GetClusterPausedSince() int64 {
+	if x != nil {
+		return x.ClusterPausedSince
+	}
+	return 0
+}
+
+func (x *AdminGRPCGetStateResponse) GetResumeHeight() int64 {
+	if x != nil {
+		return x.ResumeHeight
+	}
+	return 0
+}
+
+func (x *AdminGRPCGetStateResponse) GetHaltHeight() int64 {
+	if x != nil {
+		return x.HaltHeight
+	}
+	return 0
+}
+
+func (x *AdminGRPCGetStateResponse) GetNewChainID() string {
+	if x != nil {
+		return x.NewChainID
+	}
+	return ""
+}
+
+func (x *AdminGRPCGetStateResponse) GetStartHeight() int64 {
+	if x != nil {
+		return x.StartHeight
+	}
+	return 0
+}
+
+type AdminGRPCSetStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height  int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Round   int64 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Step    int32 `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	Cluster bool  `protobuf:"varint,4,opt,name=cluster,proto3" json:"cluster,omitempty"`
+}
+
+func (x *AdminGRPCSetStateRequest) Reset() {
+	*x = AdminGRPCSetStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGRPCSetStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGRPCSetStateRequest) ProtoMessage() {}
+
+func (x *AdminGRPCSetStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGRPCSetStateRequest.ProtoReflect.Descriptor instead.
+func (*AdminGRPCSetStateRequest) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AdminGRPCSetStateRequest) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *AdminGRPCSetStateRequest) GetRound() int64 {
+	if x != nil {
+		return x.Round
+	}
+	return 0
+}
+
+func (x *AdminGRPCSetStateRequest) GetStep() int32 {
+	if x != nil {
+		return x.Step
+	}
+	return 0
+}
+
+func (x *AdminGRPCSetStateRequest) GetCluster() bool {
+	if x != nil {
+		return x.Cluster
+	}
+	return false
+}
+
+type AdminGRPCSetStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PrivValState *AdminSignState `protobuf:"bytes,1,opt,name=privValState,proto3" json:"privValState,omitempty"`
+	ShareState   *AdminSignState `protobuf:"bytes,2,opt,name=shareState,proto3" json:"shareState,omitempty"`
+}
+
+func (x *AdminGRPCSetStateResponse) Reset() {
+	*x = AdminGRPCSetStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGRPCSetStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGRPCSetStateResponse) ProtoMessage() {}
+
+func (x *AdminGRPCSetStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGRPCSetStateResponse.ProtoReflect.Descriptor instead.
+func (*AdminGRPCSetStateResponse) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AdminGRPCSetStateResponse) GetPrivValState() *AdminSignState {
+	if x != nil {
+		return x.PrivValState
+	}
+	return nil
+}
+
+func (x *AdminGRPCSetStateResponse) GetShareState() *AdminSignState {
+	if x != nil {
+		return x.ShareState
+	}
+	return nil
+}
+
+type AdminGRPCReloadConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminGRPCReloadConfigRequest) Reset() {
+	*x = AdminGRPCReloadConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGRPCReloadConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGRPCReloadConfigRequest) ProtoMessage() {}
+
+func (x *AdminGRPCReloadConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGRPCReloadConfigRequest.ProtoReflect.Descriptor instead.
+func (*AdminGRPCReloadConfigRequest) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{9}
+}
+
+type AdminGRPCReloadConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []string `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *AdminGRPCReloadConfigResponse) Reset() {
+	*x = AdminGRPCReloadConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGRPCReloadConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGRPCReloadConfigResponse) ProtoMessage() {}
+
+func (x *AdminGRPCReloadConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGRPCReloadConfigResponse.ProtoReflect.Descriptor instead.
+func (*AdminGRPCReloadConfigResponse) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AdminGRPCReloadConfigResponse) GetChanges() []string {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type AdminPeer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShareID int32  `protobuf:"varint,1,opt,name=shareID,proto3" json:"shareID,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *AdminPeer) Reset() {
+	*x = AdminPeer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminPeer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminPeer) ProtoMessage() {}
+
+func (x *AdminPeer) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminPeer.ProtoReflect.Descriptor instead.
+func (*AdminPeer) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AdminPeer) GetShareID() int32 {
+	if x != nil {
+		return x.ShareID
+	}
+	return 0
+}
+
+func (x *AdminPeer) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type AdminSentry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Connected bool   `protobuf:"varint,2,opt,name=connected,proto3" json:"connected,omitempty"`
+}
+
+func (x *AdminSentry) Reset() {
+	*x = AdminSentry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminSentry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminSentry) ProtoMessage() {}
+
+func (x *AdminSentry) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminSentry.ProtoReflect.Descriptor instead.
+func (*AdminSentry) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *AdminSentry) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *AdminSentry) GetConnected() bool {
+	if x != nil {
+		return x.Connected
+	}
+	return false
+}
+
+type AdminGRPCListPeersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminGRPCListPeersRequest) Reset() {
+	*x = AdminGRPCListPeersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGRPCListPeersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGRPCListPeersRequest) ProtoMessage() {}
+
+func (x *AdminGRPCListPeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGRPCListPeersRequest.ProtoReflect.Descriptor instead.
+func (*AdminGRPCListPeersRequest) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{13}
+}
+
+type AdminGRPCListPeersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peers    []*AdminPeer   `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	Sentries []*AdminSentry `protobuf:"bytes,2,rep,name=sentries,proto3" json:"sentries,omitempty"`
+	Leader   string         `protobuf:"bytes,3,opt,name=leader,proto3" json:"leader,omitempty"`
+}
+
+func (x *AdminGRPCListPeersResponse) Reset() {
+	*x = AdminGRPCListPeersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGRPCListPeersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGRPCListPeersResponse) ProtoMessage() {}
+
+func (x *AdminGRPCListPeersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGRPCListPeersResponse.ProtoReflect.Descriptor instead.
+func (*AdminGRPCListPeersResponse) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AdminGRPCListPeersResponse) GetPeers() []*AdminPeer {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *AdminGRPCListPeersResponse) GetSentries() []*AdminSentry {
+	if x != nil {
+		return x.Sentries
+	}
+	return nil
+}
+
+func (x *AdminGRPCListPeersResponse) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+type AdminGRPCTransferLeadershipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LeaderID string `protobuf:"bytes,1,opt,name=leaderID,proto3" json:"leaderID,omitempty"`
+}
+
+func (x *AdminGRPCTransferLeadershipRequest) Reset() {
+	*x = AdminGRPCTransferLeadershipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGRPCTransferLeadershipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGRPCTransferLeadershipRequest) ProtoMessage() {}
+
+func (x *AdminGRPCTransferLeadershipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGRPCTransferLeadershipRequest.ProtoReflect.Descriptor instead.
+func (*AdminGRPCTransferLeadershipRequest) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *AdminGRPCTransferLeadershipRequest) GetLeaderID() string {
+	if x != nil {
+		return x.LeaderID
+	}
+	return ""
+}
+
+type AdminGRPCTransferLeadershipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Leader string `protobuf:"bytes,1,opt,name=leader,proto3" json:"leader,omitempty"`
+}
+
+func (x *AdminGRPCTransferLeadershipResponse) Reset() {
+	*x = AdminGRPCTransferLeadershipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGRPCTransferLeadershipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGRPCTransferLeadershipResponse) ProtoMessage() {}
+
+func (x *AdminGRPCTransferLeadershipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGRPCTransferLeadershipResponse.ProtoReflect.Descriptor instead.
+func (*AdminGRPCTransferLeadershipResponse) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *AdminGRPCTransferLeadershipResponse) GetLeader() string {
+	if x != nil {
+		return x.Leader
+	}
+	return ""
+}
+
+type AdminGRPCRecordAdminActionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action []byte `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+}
+
+func (x *AdminGRPCRecordAdminActionRequest) Reset() {
+	*x = AdminGRPCRecordAdminActionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGRPCRecordAdminActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGRPCRecordAdminActionRequest) ProtoMessage() {}
+
+func (x *AdminGRPCRecordAdminActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGRPCRecordAdminActionRequest.ProtoReflect.Descriptor instead.
+func (*AdminGRPCRecordAdminActionRequest) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AdminGRPCRecordAdminActionRequest) GetAction() []byte {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+type AdminGRPCRecordAdminActionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AdminGRPCRecordAdminActionResponse) Reset() {
+	*x = AdminGRPCRecordAdminActionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AdminGRPCRecordAdminActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminGRPCRecordAdminActionResponse) ProtoMessage() {}
+
+func (x *AdminGRPCRecordAdminActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_admin_grpc_server_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminGRPCRecordAdminActionResponse.ProtoReflect.Descriptor instead.
+func (*AdminGRPCRecordAdminActionResponse) Descriptor() ([]byte, []int) {
+	return file_signer_proto_admin_grpc_server_proto_rawDescGZIP(), []int{18}
+}
+
+var File_signer_proto_admin_grpc_server_proto protoreflect.FileDescriptor
+
+var file_signer_proto_admin_grpc_server_proto_rawDesc = []byte{
+	0x0a, 0x24, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6d, 0x0a,
+	0x15, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x18, 0x0a, 0x16,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x32, 0x0a, 0x16, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47,
+	0x52, 0x50, 0x43, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x37, 0x0a, 0x17, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x77, 0x61, 0x73, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x77, 0x61, 0x73, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x22, 0x70, 0x0a, 0x0e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x42,
+	0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52,
+	0x50, 0x43, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x8f, 0x04, 0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x39, 0x0a, 0x0c, 0x70, 0x72, 0x69,
+	0x76, 0x56, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x76, 0x56, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x61, 0x75, 0x73, 0x65, 0x52,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x53,
+	0x69, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x70, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x2e, 0x0a,
+	0x12, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2e, 0x0a,
+	0x12, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x53, 0x69,
+	0x6e, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x63, 0x6c, 0x75, 0x73, 0x74,
+	0x65, 0x72, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x61, 0x6c, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x68, 0x61, 0x6c, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x77, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
+	0x44, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x22, 0x76, 0x0a, 0x18, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43,
+	0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x65,
+	0x70, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x22, 0x8d, 0x01, 0x0a, 0x19,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0c, 0x70, 0x72, 0x69,
+	0x76, 0x56, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x76, 0x56, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x22, 0x1e, 0x0a, 0x1c, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x39, 0x0a, 0x1d, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x22, 0x3f, 0x0a, 0x09, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50,
+	0x65, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x45, 0x0a, 0x0b, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x53, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x65, 0x64, 0x22, 0x1b,
+	0x0a, 0x19, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x1a,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x70, 0x65,
+	0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65,
+	0x72, 0x73, 0x12, 0x2e, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x53, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x40, 0x0a, 0x22, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x44, 0x22, 0x3d, 0x0a, 0x23,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x3b, 0x0a, 0x21, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x24, 0x0a, 0x22, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x47, 0x52, 0x50, 0x43, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xcc,
+	0x05, 0x0a, 0x09, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x12, 0x46, 0x0a, 0x05,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x47, 0x52, 0x50, 0x43, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x12, 0x1d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x52,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x4f, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x53, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x53,
+	0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x5b, 0x0a, 0x0c, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47,
+	0x52, 0x50, 0x43, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x52, 0x65, 0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52,
+	0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x4c,
+	0x69, 0x73, 0x74, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x6d, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x65, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69,
+	0x6e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x6a, 0x0a, 0x11, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52, 0x50, 0x43, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x47, 0x52,
+	0x50, 0x43, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x36, 0x5a,
+	0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74, 0x72, 0x61,
+	0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x76, 0x65, 0x2d, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x2f, 0x68, 0x6f, 0x72, 0x63, 0x72, 0x75, 0x78, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_signer_proto_admin_grpc_server_proto_rawDescOnce sync.Once
+	file_signer_proto_admin_grpc_server_proto_rawDescData = file_signer_proto_admin_grpc_server_proto_rawDesc
+)
+
+func file_signer_proto_admin_grpc_server_proto_rawDescGZIP() []byte {
+	file_signer_proto_admin_grpc_server_proto_rawDescOnce.Do(func() {
+		file_signer_proto_admin_grpc_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_signer_proto_admin_grpc_server_proto_rawDescData)
+	})
+	return file_signer_proto_admin_grpc_server_proto_rawDescData
+}
+
+var file_signer_proto_admin_grpc_server_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_signer_proto_admin_grpc_server_proto_goTypes = []interface{}{
+	(*AdminGRPCPauseRequest)(nil),               // 0: proto.AdminGRPCPauseRequest
+	(*AdminGRPCPauseResponse)(nil),              // 1: proto.AdminGRPCPauseResponse
+	(*AdminGRPCResumeRequest)(nil),              // 2: proto.AdminGRPCResumeRequest
+	(*AdminGRPCResumeResponse)(nil),             // 3: proto.AdminGRPCResumeResponse
+	(*AdminSignState)(nil),                      // 4: proto.AdminSignState
+	(*AdminGRPCGetStateRequest)(nil),            // 5: proto.AdminGRPCGetStateRequest
+	(*AdminGRPCGetStateResponse)(nil),           // 6: proto.AdminGRPCGetStateResponse
+	(*AdminGRPCSetStateRequest)(nil),            // 7: proto.AdminGRPCSetStateRequest
+	(*AdminGRPCSetStateResponse)(nil),           // 8: proto.AdminGRPCSetStateResponse
+	(*AdminGRPCReloadConfigRequest)(nil),        // 9: proto.AdminGRPCReloadConfigRequest
+	(*AdminGRPCReloadConfigResponse)(nil),       // 10: proto.AdminGRPCReloadConfigResponse
+	(*AdminPeer)(nil),                           // 11: proto.AdminPeer
+	(*AdminSentry)(nil),                         // 12: proto.AdminSentry
+	(*AdminGRPCListPeersRequest)(nil),           // 13: proto.AdminGRPCListPeersRequest
+	(*AdminGRPCListPeersResponse)(nil),          // 14: proto.AdminGRPCListPeersResponse
+	(*AdminGRPCTransferLeadershipRequest)(nil),  // 15: proto.AdminGRPCTransferLeadershipRequest
+	(*AdminGRPCTransferLeadershipResponse)(nil), // 16: proto.AdminGRPCTransferLeadershipResponse
+	(*AdminGRPCRecordAdminActionRequest)(nil),   // 17: proto.AdminGRPCRecordAdminActionRequest
+	(*AdminGRPCRecordAdminActionResponse)(nil),  // 18: proto.AdminGRPCRecordAdminActionResponse
+}
+var file_signer_proto_admin_grpc_server_proto_depIdxs = []int32{
+	4,  // 0: proto.AdminGRPCGetStateResponse.privValState:type_name -> proto.AdminSignState
+	4,  // 1: proto.AdminGRPCGetStateResponse.shareState:type_name -> proto.AdminSignState
+	4,  // 2: proto.AdminGRPCSetStateResponse.privValState:type_name -> proto.AdminSignState
+	4,  // 3: proto.AdminGRPCSetStateResponse.shareState:type_name -> proto.AdminSignState
+	11, // 4: proto.AdminGRPCListPeersResponse.peers:type_name -> proto.AdminPeer
+	12, // 5: proto.AdminGRPCListPeersResponse.sentries:type_name -> proto.AdminSentry
+	0,  // 6: proto.AdminGRPC.Pause:input_type -> proto.AdminGRPCPauseRequest
+	2,  // 7: proto.AdminGRPC.Resume:input_type -> proto.AdminGRPCResumeRequest
+	5,  // 8: proto.AdminGRPC.GetState:input_type -> proto.AdminGRPCGetStateRequest
+	7,  // 9: proto.AdminGRPC.SetState:input_type -> proto.AdminGRPCSetStateRequest
+	9,  // 10: proto.AdminGRPC.ReloadConfig:input_type -> proto.AdminGRPCReloadConfigRequest
+	13, // 11: proto.AdminGRPC.ListPeers:input_type -> proto.AdminGRPCListPeersRequest
+	15, // 12: proto.AdminGRPC.TransferLeadership:input_type -> proto.AdminGRPCTransferLeadershipRequest
+	17, // 13: proto.AdminGRPC.RecordAdminAction:input_type -> proto.AdminGRPCRecordAdminActionRequest
+	1,  // 14: proto.AdminGRPC.Pause:output_type -> proto.AdminGRPCPauseResponse
+	3,  // 15: proto.AdminGRPC.Resume:output_type -> proto.AdminGRPCResumeResponse
+	6,  // 16: proto.AdminGRPC.GetState:output_type -> proto.AdminGRPCGetStateResponse
+	8,  // 17: proto.AdminGRPC.SetState:output_type -> proto.AdminGRPCSetStateResponse
+	10, // 18: proto.AdminGRPC.ReloadConfig:output_type -> proto.AdminGRPCReloadConfigResponse
+	14, // 19: proto.AdminGRPC.ListPeers:output_type -> proto.AdminGRPCListPeersResponse
+	16, // 20: proto.AdminGRPC.TransferLeadership:output_type -> proto.AdminGRPCTransferLeadershipResponse
+	18, // 21: proto.AdminGRPC.RecordAdminAction:output_type -> proto.AdminGRPCRecordAdminActionResponse
+	14, // [14:22] is the sub-list for method output_type
+	6,  // [6:14] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_signer_proto_admin_grpc_server_proto_init() }
+func file_signer_proto_admin_grpc_server_proto_init() {
+	if File_signer_proto_admin_grpc_server_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_signer_proto_admin_grpc_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGRPCPauseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGRPCPauseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGRPCResumeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGRPCResumeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSignState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGRPCGetStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGRPCGetStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGRPCSetStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGRPCSetStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGRPCReloadConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGRPCReloadConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminPeer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminSentry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGRPCListPeersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGRPCListPeersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGRPCTransferLeadershipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGRPCTransferLeadershipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGRPCRecordAdminActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_admin_grpc_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AdminGRPCRecordAdminActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_signer_proto_admin_grpc_server_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   19,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_signer_proto_admin_grpc_server_proto_goTypes,
+		DependencyIndexes: file_signer_proto_admin_grpc_server_proto_depIdxs,
+		MessageInfos:      file_signer_proto_admin_grpc_server_proto_msgTypes,
+	}.Build()
+	File_signer_proto_admin_grpc_server_proto = out.File
+	file_signer_proto_admin_grpc_server_proto_rawDesc = nil
+	file_signer_proto_admin_grpc_server_proto_goTypes = nil
+	file_signer_proto_admin_grpc_server_proto_depIdxs = nil
+}
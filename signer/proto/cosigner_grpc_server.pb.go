@@ -30,6 +30,7 @@ type Block struct {
 	Step      int32  `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
 	SignBytes []byte `protobuf:"bytes,4,opt,name=signBytes,proto3" json:"signBytes,omitempty"`
 	Timestamp int64  `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Source    string `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
 }
 
 func (x *Block) Reset() {
@@ -99,13 +100,21 @@ func (x *Block) GetTimestamp() int64 {
 	return 0
 }
 
+func (x *Block) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
 type CosignerGRPCSignBlockRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainID string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
-	Block   *Block `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	ChainID  string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
+	Block    *Block `protobuf:"bytes,2,opt,name=block,proto3" json:"block,omitempty"`
+	SourceID int32  `protobuf:"varint,3,opt,name=sourceID,proto3" json:"sourceID,omitempty"`
 }
 
 func (x *CosignerGRPCSignBlockRequest) Reset() {
@@ -154,6 +163,76 @@ func (x *CosignerGRPCSignBlockRequest) GetBlock() *Block {
 	return nil
 }
 
+func (x *CosignerGRPCSignBlockRequest) GetSourceID() int32 {
+	if x != nil {
+		return x.SourceID
+	}
+	return 0
+}
+
+type ConflictingData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reason            string `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExistingSignBytes []byte `protobuf:"bytes,2,opt,name=existingSignBytes,proto3" json:"existingSignBytes,omitempty"`
+	NewSignBytes      []byte `protobuf:"bytes,3,opt,name=newSignBytes,proto3" json:"newSignBytes,omitempty"`
+}
+
+func (x *ConflictingData) Reset() {
+	*x = ConflictingData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConflictingData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConflictingData) ProtoMessage() {}
+
+func (x *ConflictingData) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConflictingData.ProtoReflect.Descriptor instead.
+func (*ConflictingData) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ConflictingData) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ConflictingData) GetExistingSignBytes() []byte {
+	if x != nil {
+		return x.ExistingSignBytes
+	}
+	return nil
+}
+
+func (x *ConflictingData) GetNewSignBytes() []byte {
+	if x != nil {
+		return x.NewSignBytes
+	}
+	return nil
+}
+
 type CosignerGRPCSignBlockResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -165,7 +244,7 @@ type CosignerGRPCSignBlockResponse struct {
 func (x *CosignerGRPCSignBlockResponse) Reset() {
 	*x = CosignerGRPCSignBlockResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[2]
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -178,7 +257,7 @@ func (x *CosignerGRPCSignBlockResponse) String() string {
 func (*CosignerGRPCSignBlockResponse) ProtoMessage() {}
 
 func (x *CosignerGRPCSignBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[2]
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -191,7 +270,7 @@ func (x *CosignerGRPCSignBlockResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CosignerGRPCSignBlockResponse.ProtoReflect.Descriptor instead.
 func (*CosignerGRPCSignBlockResponse) Descriptor() ([]byte, []int) {
-	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{2}
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CosignerGRPCSignBlockResponse) GetSignature() []byte {
@@ -216,7 +295,7 @@ type EphemeralSecretPart struct {
 func (x *EphemeralSecretPart) Reset() {
 	*x = EphemeralSecretPart{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[3]
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -229,7 +308,7 @@ func (x *EphemeralSecretPart) String() string {
 func (*EphemeralSecretPart) ProtoMessage() {}
 
 func (x *EphemeralSecretPart) ProtoReflect() protoreflect.Message {
-	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[3]
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -242,7 +321,7 @@ func (x *EphemeralSecretPart) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EphemeralSecretPart.ProtoReflect.Descriptor instead.
 func (*EphemeralSecretPart) Descriptor() ([]byte, []int) {
-	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{3}
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *EphemeralSecretPart) GetSourceID() int32 {
@@ -294,7 +373,7 @@ type HRST struct {
 func (x *HRST) Reset() {
 	*x = HRST{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[4]
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -307,7 +386,7 @@ func (x *HRST) String() string {
 func (*HRST) ProtoMessage() {}
 
 func (x *HRST) ProtoReflect() protoreflect.Message {
-	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[4]
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -320,7 +399,7 @@ func (x *HRST) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HRST.ProtoReflect.Descriptor instead.
 func (*HRST) Descriptor() ([]byte, []int) {
-	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{4}
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *HRST) GetHeight() int64 {
@@ -364,7 +443,7 @@ type CosignerGRPCSetEphemeralSecretPartsAndSignRequest struct {
 func (x *CosignerGRPCSetEphemeralSecretPartsAndSignRequest) Reset() {
 	*x = CosignerGRPCSetEphemeralSecretPartsAndSignRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[5]
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -377,7 +456,7 @@ func (x *CosignerGRPCSetEphemeralSecretPartsAndSignRequest) String() string {
 func (*CosignerGRPCSetEphemeralSecretPartsAndSignRequest) ProtoMessage() {}
 
 func (x *CosignerGRPCSetEphemeralSecretPartsAndSignRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[5]
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -390,7 +469,7 @@ func (x *CosignerGRPCSetEphemeralSecretPartsAndSignRequest) ProtoReflect() proto
 
 // Deprecated: Use CosignerGRPCSetEphemeralSecretPartsAndSignRequest.ProtoReflect.Descriptor instead.
 func (*CosignerGRPCSetEphemeralSecretPartsAndSignRequest) Descriptor() ([]byte, []int) {
-	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{5}
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CosignerGRPCSetEphemeralSecretPartsAndSignRequest) GetEncryptedSecrets() []*EphemeralSecretPart {
@@ -427,7 +506,7 @@ type CosignerGRPCSetEphemeralSecretPartsAndSignResponse struct {
 func (x *CosignerGRPCSetEphemeralSecretPartsAndSignResponse) Reset() {
 	*x = CosignerGRPCSetEphemeralSecretPartsAndSignResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[6]
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -440,7 +519,7 @@ func (x *CosignerGRPCSetEphemeralSecretPartsAndSignResponse) String() string {
 func (*CosignerGRPCSetEphemeralSecretPartsAndSignResponse) ProtoMessage() {}
 
 func (x *CosignerGRPCSetEphemeralSecretPartsAndSignResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[6]
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -453,7 +532,7 @@ func (x *CosignerGRPCSetEphemeralSecretPartsAndSignResponse) ProtoReflect() prot
 
 // Deprecated: Use CosignerGRPCSetEphemeralSecretPartsAndSignResponse.ProtoReflect.Descriptor instead.
 func (*CosignerGRPCSetEphemeralSecretPartsAndSignResponse) Descriptor() ([]byte, []int) {
-	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{6}
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CosignerGRPCSetEphemeralSecretPartsAndSignResponse) GetEphemeralPublic() []byte {
@@ -488,7 +567,7 @@ type CosignerGRPCGetEphemeralSecretPartsRequest struct {
 func (x *CosignerGRPCGetEphemeralSecretPartsRequest) Reset() {
 	*x = CosignerGRPCGetEphemeralSecretPartsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[7]
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -501,7 +580,7 @@ func (x *CosignerGRPCGetEphemeralSecretPartsRequest) String() string {
 func (*CosignerGRPCGetEphemeralSecretPartsRequest) ProtoMessage() {}
 
 func (x *CosignerGRPCGetEphemeralSecretPartsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[7]
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,7 +593,7 @@ func (x *CosignerGRPCGetEphemeralSecretPartsRequest) ProtoReflect() protoreflect
 
 // Deprecated: Use CosignerGRPCGetEphemeralSecretPartsRequest.ProtoReflect.Descriptor instead.
 func (*CosignerGRPCGetEphemeralSecretPartsRequest) Descriptor() ([]byte, []int) {
-	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{7}
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CosignerGRPCGetEphemeralSecretPartsRequest) GetHrst() *HRST {
@@ -535,7 +614,7 @@ type CosignerGRPCGetEphemeralSecretPartsResponse struct {
 func (x *CosignerGRPCGetEphemeralSecretPartsResponse) Reset() {
 	*x = CosignerGRPCGetEphemeralSecretPartsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[8]
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -548,7 +627,7 @@ func (x *CosignerGRPCGetEphemeralSecretPartsResponse) String() string {
 func (*CosignerGRPCGetEphemeralSecretPartsResponse) ProtoMessage() {}
 
 func (x *CosignerGRPCGetEphemeralSecretPartsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[8]
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -561,7 +640,7 @@ func (x *CosignerGRPCGetEphemeralSecretPartsResponse) ProtoReflect() protoreflec
 
 // Deprecated: Use CosignerGRPCGetEphemeralSecretPartsResponse.ProtoReflect.Descriptor instead.
 func (*CosignerGRPCGetEphemeralSecretPartsResponse) Descriptor() ([]byte, []int) {
-	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{8}
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CosignerGRPCGetEphemeralSecretPartsResponse) GetEncryptedSecrets() []*EphemeralSecretPart {
@@ -582,7 +661,7 @@ type CosignerGRPCTransferLeadershipRequest struct {
 func (x *CosignerGRPCTransferLeadershipRequest) Reset() {
 	*x = CosignerGRPCTransferLeadershipRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[9]
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -595,7 +674,7 @@ func (x *CosignerGRPCTransferLeadershipRequest) String() string {
 func (*CosignerGRPCTransferLeadershipRequest) ProtoMessage() {}
 
 func (x *CosignerGRPCTransferLeadershipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[9]
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -608,7 +687,7 @@ func (x *CosignerGRPCTransferLeadershipRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use CosignerGRPCTransferLeadershipRequest.ProtoReflect.Descriptor instead.
 func (*CosignerGRPCTransferLeadershipRequest) Descriptor() ([]byte, []int) {
-	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{9}
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *CosignerGRPCTransferLeadershipRequest) GetLeaderID() string {
@@ -630,7 +709,7 @@ type CosignerGRPCTransferLeadershipResponse struct {
 func (x *CosignerGRPCTransferLeadershipResponse) Reset() {
 	*x = CosignerGRPCTransferLeadershipResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[10]
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -643,7 +722,7 @@ func (x *CosignerGRPCTransferLeadershipResponse) String() string {
 func (*CosignerGRPCTransferLeadershipResponse) ProtoMessage() {}
 
 func (x *CosignerGRPCTransferLeadershipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[10]
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -656,7 +735,7 @@ func (x *CosignerGRPCTransferLeadershipResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use CosignerGRPCTransferLeadershipResponse.ProtoReflect.Descriptor instead.
 func (*CosignerGRPCTransferLeadershipResponse) Descriptor() ([]byte, []int) {
-	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{10}
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *CosignerGRPCTransferLeadershipResponse) GetLeaderID() string {
@@ -682,7 +761,7 @@ type CosignerGRPCGetLeaderRequest struct {
 func (x *CosignerGRPCGetLeaderRequest) Reset() {
 	*x = CosignerGRPCGetLeaderRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[11]
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -695,7 +774,7 @@ func (x *CosignerGRPCGetLeaderRequest) String() string {
 func (*CosignerGRPCGetLeaderRequest) ProtoMessage() {}
 
 func (x *CosignerGRPCGetLeaderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[11]
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -708,7 +787,7 @@ func (x *CosignerGRPCGetLeaderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CosignerGRPCGetLeaderRequest.ProtoReflect.Descriptor instead.
 func (*CosignerGRPCGetLeaderRequest) Descriptor() ([]byte, []int) {
-	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{11}
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{12}
 }
 
 type CosignerGRPCGetLeaderResponse struct {
@@ -722,7 +801,7 @@ type CosignerGRPCGetLeaderResponse struct {
 func (x *CosignerGRPCGetLeaderResponse) Reset() {
 	*x = CosignerGRPCGetLeaderResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[12]
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -735,7 +814,7 @@ func (x *CosignerGRPCGetLeaderResponse) String() string {
 func (*CosignerGRPCGetLeaderResponse) ProtoMessage() {}
 
 func (x *CosignerGRPCGetLeaderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[12]
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -748,7 +827,7 @@ func (x *CosignerGRPCGetLeaderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CosignerGRPCGetLeaderResponse.ProtoReflect.Descriptor instead.
 func (*CosignerGRPCGetLeaderResponse) Descriptor() ([]byte, []int) {
-	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{12}
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *CosignerGRPCGetLeaderResponse) GetLeader() string {
@@ -758,229 +837,1436 @@ func (x *CosignerGRPCGetLeaderResponse) GetLeader() string {
 	return ""
 }
 
-var File_signer_proto_cosigner_grpc_server_proto protoreflect.FileDescriptor
+type CosignerGRPCAddPeerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_signer_proto_cosigner_grpc_server_proto_rawDesc = []byte{
-	0x0a, 0x27, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
-	0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x85, 0x01, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
-	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x5c, 0x0a, 0x1c, 0x43, 0x6f, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53, 0x69, 0x67, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x49, 0x44, 0x12, 0x22, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x3d, 0x0a, 0x1d, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53, 0x69, 0x67, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e,
-	0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xed, 0x01, 0x0a, 0x13, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65,
-	0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x73,
-	0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12,
-	0x46, 0x0a, 0x1e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72,
-	0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
-	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45,
-	0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x50, 0x61, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x53, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x53, 0x69, 0x67, 0x22, 0x66, 0x0a, 0x04, 0x48, 0x52, 0x53, 0x54, 0x12, 0x16, 0x0a,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12,
-	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xba, 0x01,
-	0x0a, 0x31, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53, 0x65,
-	0x74, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
-	0x50, 0x61, 0x72, 0x74, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x52, 0x10, 0x65, 0x6e, 0x63, 0x72, 0x79,
-	0x70, 0x74, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x04, 0x68,
-	0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2e, 0x48, 0x52, 0x53, 0x54, 0x52, 0x04, 0x68, 0x72, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
-	0x73, 0x69, 0x67, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x09, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x32, 0x43,
-	0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53, 0x65, 0x74, 0x45, 0x70,
-	0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72,
-	0x74, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x70, 0x68, 0x65,
-	0x6d, 0x65, 0x72, 0x61, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67,
-	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x4d, 0x0a, 0x2a, 0x43, 0x6f, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65, 0x74, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65,
-	0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x68, 0x72, 0x73, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x52, 0x53, 0x54,
-	0x52, 0x04, 0x68, 0x72, 0x73, 0x74, 0x22, 0x75, 0x0a, 0x2b, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65, 0x74, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72,
-	0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74,
-	0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61,
-	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x52, 0x10, 0x65, 0x6e, 0x63,
-	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x22, 0x43, 0x0a,
-	0x25, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x49, 0x44, 0x22, 0x6a, 0x0a, 0x26, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52,
-	0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1e,
-	0x0a, 0x1c, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65,
-	0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37,
-	0x0a, 0x1d, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65,
-	0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x32, 0xd6, 0x04, 0x0a, 0x0c, 0x43, 0x6f, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x12, 0x58, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53, 0x69, 0x67, 0x6e, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53,
-	0x69, 0x67, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x97, 0x01, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65,
-	0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x41, 0x6e,
-	0x64, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x38, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53, 0x65, 0x74, 0x45, 0x70, 0x68,
-	0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74,
-	0x73, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x39, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
-	0x47, 0x52, 0x50, 0x43, 0x53, 0x65, 0x74, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x69,
-	0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x82, 0x01, 0x0a,
-	0x17, 0x47, 0x65, 0x74, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x63,
-	0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x31, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65, 0x74,
-	0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50,
-	0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43,
-	0x47, 0x65, 0x74, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72,
-	0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x73, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
-	0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x65, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69,
-	0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65, 0x74,
-	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73,
-	0x74, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x76, 0x65, 0x2d, 0x76, 0x65, 0x6e, 0x74, 0x75,
-	0x72, 0x65, 0x73, 0x2f, 0x68, 0x6f, 0x72, 0x63, 0x72, 0x75, 0x78, 0x2f, 0x73, 0x69, 0x67, 0x6e,
-	0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	ShareID int32  `protobuf:"varint,1,opt,name=shareID,proto3" json:"shareID,omitempty"`
+	P2PAddr string `protobuf:"bytes,2,opt,name=p2pAddr,proto3" json:"p2pAddr,omitempty"`
 }
 
-var (
-	file_signer_proto_cosigner_grpc_server_proto_rawDescOnce sync.Once
-	file_signer_proto_cosigner_grpc_server_proto_rawDescData = file_signer_proto_cosigner_grpc_server_proto_rawDesc
-)
+func (x *CosignerGRPCAddPeerRequest) Reset() {
+	*x = CosignerGRPCAddPeerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP() []byte {
-	file_signer_proto_cosigner_grpc_server_proto_rawDescOnce.Do(func() {
-		file_signer_proto_cosigner_grpc_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_signer_proto_cosigner_grpc_server_proto_rawDescData)
-	})
-	return file_signer_proto_cosigner_grpc_server_proto_rawDescData
+func (x *CosignerGRPCAddPeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_signer_proto_cosigner_grpc_server_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
-var file_signer_proto_cosigner_grpc_server_proto_goTypes = []interface{}{
-	(*Block)(nil),                         // 0: proto.Block
-	(*CosignerGRPCSignBlockRequest)(nil),  // 1: proto.CosignerGRPCSignBlockRequest
-	(*CosignerGRPCSignBlockResponse)(nil), // 2: proto.CosignerGRPCSignBlockResponse
-	(*EphemeralSecretPart)(nil),           // 3: proto.EphemeralSecretPart
-	(*HRST)(nil),                          // 4: proto.HRST
-	(*CosignerGRPCSetEphemeralSecretPartsAndSignRequest)(nil),  // 5: proto.CosignerGRPCSetEphemeralSecretPartsAndSignRequest
-	(*CosignerGRPCSetEphemeralSecretPartsAndSignResponse)(nil), // 6: proto.CosignerGRPCSetEphemeralSecretPartsAndSignResponse
-	(*CosignerGRPCGetEphemeralSecretPartsRequest)(nil),         // 7: proto.CosignerGRPCGetEphemeralSecretPartsRequest
-	(*CosignerGRPCGetEphemeralSecretPartsResponse)(nil),        // 8: proto.CosignerGRPCGetEphemeralSecretPartsResponse
-	(*CosignerGRPCTransferLeadershipRequest)(nil),              // 9: proto.CosignerGRPCTransferLeadershipRequest
-	(*CosignerGRPCTransferLeadershipResponse)(nil),             // 10: proto.CosignerGRPCTransferLeadershipResponse
-	(*CosignerGRPCGetLeaderRequest)(nil),                       // 11: proto.CosignerGRPCGetLeaderRequest
-	(*CosignerGRPCGetLeaderResponse)(nil),                      // 12: proto.CosignerGRPCGetLeaderResponse
+func (*CosignerGRPCAddPeerRequest) ProtoMessage() {}
+
+func (x *CosignerGRPCAddPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_signer_proto_cosigner_grpc_server_proto_depIdxs = []int32{
-	0,  // 0: proto.CosignerGRPCSignBlockRequest.block:type_name -> proto.Block
-	3,  // 1: proto.CosignerGRPCSetEphemeralSecretPartsAndSignRequest.encryptedSecrets:type_name -> proto.EphemeralSecretPart
-	4,  // 2: proto.CosignerGRPCSetEphemeralSecretPartsAndSignRequest.hrst:type_name -> proto.HRST
-	4,  // 3: proto.CosignerGRPCGetEphemeralSecretPartsRequest.hrst:type_name -> proto.HRST
-	3,  // 4: proto.CosignerGRPCGetEphemeralSecretPartsResponse.encryptedSecrets:type_name -> proto.EphemeralSecretPart
-	1,  // 5: proto.CosignerGRPC.SignBlock:input_type -> proto.CosignerGRPCSignBlockRequest
-	5,  // 6: proto.CosignerGRPC.SetEphemeralSecretPartsAndSign:input_type -> proto.CosignerGRPCSetEphemeralSecretPartsAndSignRequest
-	7,  // 7: proto.CosignerGRPC.GetEphemeralSecretParts:input_type -> proto.CosignerGRPCGetEphemeralSecretPartsRequest
-	9,  // 8: proto.CosignerGRPC.TransferLeadership:input_type -> proto.CosignerGRPCTransferLeadershipRequest
-	11, // 9: proto.CosignerGRPC.GetLeader:input_type -> proto.CosignerGRPCGetLeaderRequest
-	2,  // 10: proto.CosignerGRPC.SignBlock:output_type -> proto.CosignerGRPCSignBlockResponse
-	6,  // 11: proto.CosignerGRPC.SetEphemeralSecretPartsAndSign:output_type -> proto.CosignerGRPCSetEphemeralSecretPartsAndSignResponse
-	8,  // 12: proto.CosignerGRPC.GetEphemeralSecretParts:output_type -> proto.CosignerGRPCGetEphemeralSecretPartsResponse
-	10, // 13: proto.CosignerGRPC.TransferLeadership:output_type -> proto.CosignerGRPCTransferLeadershipResponse
-	12, // 14: proto.CosignerGRPC.GetLeader:output_type -> proto.CosignerGRPCGetLeaderResponse
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+
+// Deprecated: Use CosignerGRPCAddPeerRequest.ProtoReflect.Descriptor instead.
+func (*CosignerGRPCAddPeerRequest) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{14}
 }
 
-func init() { file_signer_proto_cosigner_grpc_server_proto_init() }
-func file_signer_proto_cosigner_grpc_server_proto_init() {
-	if File_signer_proto_cosigner_grpc_server_proto != nil {
-		return
+func (x *CosignerGRPCAddPeerRequest) GetShareID() int32 {
+	if x != nil {
+		return x.ShareID
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_signer_proto_cosigner_grpc_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Block); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+	return 0
+}
+
+func (x *CosignerGRPCAddPeerRequest) GetP2PAddr() string {
+	if x != nil {
+		return x.P2PAddr
+	}
+	return ""
+}
+
+type CosignerGRPCAddPeerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CosignerGRPCAddPeerResponse) Reset() {
+	*x = CosignerGRPCAddPeerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosignerGRPCAddPeerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosignerGRPCAddPeerResponse) ProtoMessage() {}
+
+func (x *CosignerGRPCAddPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_signer_proto_cosigner_grpc_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CosignerGRPCSignBlockRequest); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosignerGRPCAddPeerResponse.ProtoReflect.Descriptor instead.
+func (*CosignerGRPCAddPeerResponse) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{15}
+}
+
+type CosignerGRPCRemovePeerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShareID int32 `protobuf:"varint,1,opt,name=shareID,proto3" json:"shareID,omitempty"`
+}
+
+func (x *CosignerGRPCRemovePeerRequest) Reset() {
+	*x = CosignerGRPCRemovePeerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosignerGRPCRemovePeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosignerGRPCRemovePeerRequest) ProtoMessage() {}
+
+func (x *CosignerGRPCRemovePeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
-		file_signer_proto_cosigner_grpc_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CosignerGRPCSignBlockResponse); i {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosignerGRPCRemovePeerRequest.ProtoReflect.Descriptor instead.
+func (*CosignerGRPCRemovePeerRequest) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CosignerGRPCRemovePeerRequest) GetShareID() int32 {
+	if x != nil {
+		return x.ShareID
+	}
+	return 0
+}
+
+type CosignerGRPCRemovePeerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CosignerGRPCRemovePeerResponse) Reset() {
+	*x = CosignerGRPCRemovePeerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosignerGRPCRemovePeerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosignerGRPCRemovePeerResponse) ProtoMessage() {}
+
+func (x *CosignerGRPCRemovePeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosignerGRPCRemovePeerResponse.ProtoReflect.Descriptor instead.
+func (*CosignerGRPCRemovePeerResponse) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{17}
+}
+
+type PeerLatency struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShareID   int32  `protobuf:"varint,1,opt,name=shareID,proto3" json:"shareID,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Latency   int64  `protobuf:"varint,3,opt,name=latency,proto3" json:"latency,omitempty"`
+	Error     string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	ClockSkew int64  `protobuf:"varint,5,opt,name=clockSkew,proto3" json:"clockSkew,omitempty"`
+}
+
+func (x *PeerLatency) Reset() {
+	*x = PeerLatency{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeerLatency) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeerLatency) ProtoMessage() {}
+
+func (x *PeerLatency) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeerLatency.ProtoReflect.Descriptor instead.
+func (*PeerLatency) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PeerLatency) GetShareID() int32 {
+	if x != nil {
+		return x.ShareID
+	}
+	return 0
+}
+
+func (x *PeerLatency) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PeerLatency) GetLatency() int64 {
+	if x != nil {
+		return x.Latency
+	}
+	return 0
+}
+
+func (x *PeerLatency) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PeerLatency) GetClockSkew() int64 {
+	if x != nil {
+		return x.ClockSkew
+	}
+	return 0
+}
+
+type CosignerGRPCGetStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CosignerGRPCGetStatusRequest) Reset() {
+	*x = CosignerGRPCGetStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosignerGRPCGetStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosignerGRPCGetStatusRequest) ProtoMessage() {}
+
+func (x *CosignerGRPCGetStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosignerGRPCGetStatusRequest.ProtoReflect.Descriptor instead.
+func (*CosignerGRPCGetStatusRequest) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{19}
+}
+
+type CosignerGRPCGetStatusResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ShareID             int32          `protobuf:"varint,1,opt,name=shareID,proto3" json:"shareID,omitempty"`
+	Version             string         `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	RaftState           string         `protobuf:"bytes,3,opt,name=raftState,proto3" json:"raftState,omitempty"`
+	Term                uint64         `protobuf:"varint,4,opt,name=term,proto3" json:"term,omitempty"`
+	CommitIndex         uint64         `protobuf:"varint,5,opt,name=commitIndex,proto3" json:"commitIndex,omitempty"`
+	AppliedIndex        uint64         `protobuf:"varint,6,opt,name=appliedIndex,proto3" json:"appliedIndex,omitempty"`
+	LastContact         int64          `protobuf:"varint,7,opt,name=lastContact,proto3" json:"lastContact,omitempty"`
+	LastSignState       *HRST          `protobuf:"bytes,8,opt,name=lastSignState,proto3" json:"lastSignState,omitempty"`
+	ShareSignState      *HRST          `protobuf:"bytes,9,opt,name=shareSignState,proto3" json:"shareSignState,omitempty"`
+	Peers               []*PeerLatency `protobuf:"bytes,10,rep,name=peers,proto3" json:"peers,omitempty"`
+	Witness             bool           `protobuf:"varint,11,opt,name=witness,proto3" json:"witness,omitempty"`
+	Sentries            int32          `protobuf:"varint,12,opt,name=sentries,proto3" json:"sentries,omitempty"`
+	Paused              bool           `protobuf:"varint,13,opt,name=paused,proto3" json:"paused,omitempty"`
+	SignRequestArrivals []*HRST        `protobuf:"bytes,14,rep,name=signRequestArrivals,proto3" json:"signRequestArrivals,omitempty"`
+}
+
+func (x *CosignerGRPCGetStatusResponse) Reset() {
+	*x = CosignerGRPCGetStatusResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosignerGRPCGetStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosignerGRPCGetStatusResponse) ProtoMessage() {}
+
+func (x *CosignerGRPCGetStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosignerGRPCGetStatusResponse.ProtoReflect.Descriptor instead.
+func (*CosignerGRPCGetStatusResponse) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CosignerGRPCGetStatusResponse) GetShareID() int32 {
+	if x != nil {
+		return x.ShareID
+	}
+	return 0
+}
+
+func (x *CosignerGRPCGetStatusResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *CosignerGRPCGetStatusResponse) GetRaftState() string {
+	if x != nil {
+		return x.RaftState
+	}
+	return ""
+}
+
+func (x *CosignerGRPCGetStatusResponse) GetTerm() uint64 {
+	if x != nil {
+		return x.Term
+	}
+	return 0
+}
+
+func (x *CosignerGRPCGetStatusResponse) GetCommitIndex() uint64 {
+	if x != nil {
+		return x.CommitIndex
+	}
+	return 0
+}
+
+func (x *CosignerGRPCGetStatusResponse) GetAppliedIndex() uint64 {
+	if x != nil {
+		return x.AppliedIndex
+	}
+	return 0
+}
+
+func (x *CosignerGRPCGetStatusResponse) GetLastContact() int64 {
+	if x != nil {
+		return x.LastContact
+	}
+	return 0
+}
+
+func (x *CosignerGRPCGetStatusResponse) GetLastSignState() *HRST {
+	if x != nil {
+		return x.LastSignState
+	}
+	return nil
+}
+
+func (x *CosignerGRPCGetStatusResponse) GetShareSignState() *HRST {
+	if x != nil {
+		return x.ShareSignState
+	}
+	return nil
+}
+
+func (x *CosignerGRPCGetStatusResponse) GetPeers() []*PeerLatency {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+func (x *CosignerGRPCGetStatusResponse) GetWitness() bool {
+	if x != nil {
+		return x.Witness
+	}
+	return false
+}
+
+func (x *CosignerGRPCGetStatusResponse) GetSentries() int32 {
+	if x != nil {
+		return x.Sentries
+	}
+	return 0
+}
+
+func (x *CosignerGRPCGetStatusResponse) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *CosignerGRPCGetStatusResponse) GetSignRequestArrivals() []*HRST {
+	if x != nil {
+		return x.SignRequestArrivals
+	}
+	return nil
+}
+
+type CosignerGRPCPingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CosignerGRPCPingRequest) Reset() {
+	*x = CosignerGRPCPingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosignerGRPCPingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosignerGRPCPingRequest) ProtoMessage() {}
+
+func (x *CosignerGRPCPingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosignerGRPCPingRequest.ProtoReflect.Descriptor instead.
+func (*CosignerGRPCPingRequest) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{21}
+}
+
+type CosignerGRPCPingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time int64 `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *CosignerGRPCPingResponse) Reset() {
+	*x = CosignerGRPCPingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosignerGRPCPingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosignerGRPCPingResponse) ProtoMessage() {}
+
+func (x *CosignerGRPCPingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosignerGRPCPingResponse.ProtoReflect.Descriptor instead.
+func (*CosignerGRPCPingResponse) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *CosignerGRPCPingResponse) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type CosignerGRPCGetLastSignStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CosignerGRPCGetLastSignStateRequest) Reset() {
+	*x = CosignerGRPCGetLastSignStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosignerGRPCGetLastSignStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosignerGRPCGetLastSignStateRequest) ProtoMessage() {}
+
+func (x *CosignerGRPCGetLastSignStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosignerGRPCGetLastSignStateRequest.ProtoReflect.Descriptor instead.
+func (*CosignerGRPCGetLastSignStateRequest) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{23}
+}
+
+type CosignerGRPCGetLastSignStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hrst      *HRST  `protobuf:"bytes,1,opt,name=hrst,proto3" json:"hrst,omitempty"`
+	SignBytes []byte `protobuf:"bytes,2,opt,name=signBytes,proto3" json:"signBytes,omitempty"`
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *CosignerGRPCGetLastSignStateResponse) Reset() {
+	*x = CosignerGRPCGetLastSignStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosignerGRPCGetLastSignStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosignerGRPCGetLastSignStateResponse) ProtoMessage() {}
+
+func (x *CosignerGRPCGetLastSignStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosignerGRPCGetLastSignStateResponse.ProtoReflect.Descriptor instead.
+func (*CosignerGRPCGetLastSignStateResponse) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CosignerGRPCGetLastSignStateResponse) GetHrst() *HRST {
+	if x != nil {
+		return x.Hrst
+	}
+	return nil
+}
+
+func (x *CosignerGRPCGetLastSignStateResponse) GetSignBytes() []byte {
+	if x != nil {
+		return x.SignBytes
+	}
+	return nil
+}
+
+func (x *CosignerGRPCGetLastSignStateResponse) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type ClusterRequestAuth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SourceID  int32  `protobuf:"varint,1,opt,name=sourceID,proto3" json:"sourceID,omitempty"`
+	Timestamp int64  `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *ClusterRequestAuth) Reset() {
+	*x = ClusterRequestAuth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClusterRequestAuth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClusterRequestAuth) ProtoMessage() {}
+
+func (x *ClusterRequestAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClusterRequestAuth.ProtoReflect.Descriptor instead.
+func (*ClusterRequestAuth) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ClusterRequestAuth) GetSourceID() int32 {
+	if x != nil {
+		return x.SourceID
+	}
+	return 0
+}
+
+func (x *ClusterRequestAuth) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *ClusterRequestAuth) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type CosignerGRPCRecordAdminActionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action []byte              `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Auth   *ClusterRequestAuth `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth,omitempty"`
+}
+
+func (x *CosignerGRPCRecordAdminActionRequest) Reset() {
+	*x = CosignerGRPCRecordAdminActionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosignerGRPCRecordAdminActionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosignerGRPCRecordAdminActionRequest) ProtoMessage() {}
+
+func (x *CosignerGRPCRecordAdminActionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosignerGRPCRecordAdminActionRequest.ProtoReflect.Descriptor instead.
+func (*CosignerGRPCRecordAdminActionRequest) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *CosignerGRPCRecordAdminActionRequest) GetAction() []byte {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *CosignerGRPCRecordAdminActionRequest) GetAuth() *ClusterRequestAuth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+type CosignerGRPCRecordAdminActionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CosignerGRPCRecordAdminActionResponse) Reset() {
+	*x = CosignerGRPCRecordAdminActionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosignerGRPCRecordAdminActionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosignerGRPCRecordAdminActionResponse) ProtoMessage() {}
+
+func (x *CosignerGRPCRecordAdminActionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosignerGRPCRecordAdminActionResponse.ProtoReflect.Descriptor instead.
+func (*CosignerGRPCRecordAdminActionResponse) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{27}
+}
+
+type CosignerGRPCSetClusterPauseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Paused       bool                `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	Reason       string              `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ResumeHeight int64               `protobuf:"varint,3,opt,name=resumeHeight,proto3" json:"resumeHeight,omitempty"`
+	Auth         *ClusterRequestAuth `protobuf:"bytes,4,opt,name=auth,proto3" json:"auth,omitempty"`
+}
+
+func (x *CosignerGRPCSetClusterPauseRequest) Reset() {
+	*x = CosignerGRPCSetClusterPauseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosignerGRPCSetClusterPauseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosignerGRPCSetClusterPauseRequest) ProtoMessage() {}
+
+func (x *CosignerGRPCSetClusterPauseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosignerGRPCSetClusterPauseRequest.ProtoReflect.Descriptor instead.
+func (*CosignerGRPCSetClusterPauseRequest) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *CosignerGRPCSetClusterPauseRequest) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *CosignerGRPCSetClusterPauseRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CosignerGRPCSetClusterPauseRequest) GetResumeHeight() int64 {
+	if x != nil {
+		return x.ResumeHeight
+	}
+	return 0
+}
+
+func (x *CosignerGRPCSetClusterPauseRequest) GetAuth() *ClusterRequestAuth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+type CosignerGRPCSetClusterPauseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CosignerGRPCSetClusterPauseResponse) Reset() {
+	*x = CosignerGRPCSetClusterPauseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosignerGRPCSetClusterPauseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosignerGRPCSetClusterPauseResponse) ProtoMessage() {}
+
+func (x *CosignerGRPCSetClusterPauseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosignerGRPCSetClusterPauseResponse.ProtoReflect.Descriptor instead.
+func (*CosignerGRPCSetClusterPauseResponse) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{29}
+}
+
+type CosignerGRPCSetClusterSignStateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hrst *HRST               `protobuf:"bytes,1,opt,name=hrst,proto3" json:"hrst,omitempty"`
+	Auth *ClusterRequestAuth `protobuf:"bytes,2,opt,name=auth,proto3" json:"auth,omitempty"`
+}
+
+func (x *CosignerGRPCSetClusterSignStateRequest) Reset() {
+	*x = CosignerGRPCSetClusterSignStateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosignerGRPCSetClusterSignStateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosignerGRPCSetClusterSignStateRequest) ProtoMessage() {}
+
+func (x *CosignerGRPCSetClusterSignStateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosignerGRPCSetClusterSignStateRequest.ProtoReflect.Descriptor instead.
+func (*CosignerGRPCSetClusterSignStateRequest) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CosignerGRPCSetClusterSignStateRequest) GetHrst() *HRST {
+	if x != nil {
+		return x.Hrst
+	}
+	return nil
+}
+
+func (x *CosignerGRPCSetClusterSignStateRequest) GetAuth() *ClusterRequestAuth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+type CosignerGRPCSetClusterSignStateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CosignerGRPCSetClusterSignStateResponse) Reset() {
+	*x = CosignerGRPCSetClusterSignStateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CosignerGRPCSetClusterSignStateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CosignerGRPCSetClusterSignStateResponse) ProtoMessage() {}
+
+func (x *CosignerGRPCSetClusterSignStateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_signer_proto_cosigner_grpc_server_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CosignerGRPCSetClusterSignStateResponse.ProtoReflect.Descriptor instead.
+func (*CosignerGRPCSetClusterSignStateResponse) Descriptor() ([]byte, []int) {
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP(), []int{31}
+}
+
+var File_signer_proto_cosigner_grpc_server_proto protoreflect.FileDescriptor
+
+var file_signer_proto_cosigner_grpc_server_proto_rawDesc = []byte{
+	0x0a, 0x27, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63,
+	0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x67, 0x72, 0x70, 0x63, 0x5f, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x9d, 0x01, 0x0a, 0x05, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x69, 0x67, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x22, 0x78, 0x0a, 0x1c, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43,
+	0x53, 0x69, 0x67, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x44, 0x12, 0x22, 0x0a, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x22, 0x7b, 0x0a, 0x0f, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x69, 0x67, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x11, 0x65, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x69, 0x67, 0x6e, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x53, 0x69, 0x67, 0x6e, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x53, 0x69,
+	0x67, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x1d, 0x43, 0x6f, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53, 0x69, 0x67, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xed, 0x01, 0x0a, 0x13, 0x45, 0x70, 0x68, 0x65, 0x6d,
+	0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0d, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x46, 0x0a, 0x1e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65,
+	0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x1e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x53, 0x68, 0x61, 0x72, 0x65, 0x50, 0x61, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x12, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x50, 0x61, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x53, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x53, 0x69, 0x67, 0x22, 0x66, 0x0a, 0x04, 0x48, 0x52, 0x53, 0x54, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0xba,
+	0x01, 0x0a, 0x31, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53,
+	0x65, 0x74, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65,
+	0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x52, 0x10, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x12, 0x1f, 0x0a, 0x04,
+	0x68, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x48, 0x52, 0x53, 0x54, 0x52, 0x04, 0x68, 0x72, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x32,
+	0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53, 0x65, 0x74, 0x45,
+	0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61,
+	0x72, 0x74, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0f, 0x65, 0x70, 0x68,
+	0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69,
+	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73,
+	0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x4d, 0x0a, 0x2a, 0x43, 0x6f, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65, 0x74, 0x45, 0x70, 0x68, 0x65, 0x6d,
+	0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x04, 0x68, 0x72, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x52, 0x53,
+	0x54, 0x52, 0x04, 0x68, 0x72, 0x73, 0x74, 0x22, 0x75, 0x0a, 0x2b, 0x43, 0x6f, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65, 0x74, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65,
+	0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x10, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70,
+	0x74, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72,
+	0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x52, 0x10, 0x65, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x73, 0x22, 0x43,
+	0x0a, 0x25, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0x6a, 0x0a, 0x26, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47,
+	0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0x1e, 0x0a, 0x1c, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x37, 0x0a, 0x1d, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47,
+	0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x50, 0x0a, 0x1a, 0x43, 0x6f, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x70, 0x32, 0x70, 0x41, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x70, 0x32, 0x70, 0x41, 0x64, 0x64, 0x72, 0x22, 0x1d, 0x0a, 0x1b, 0x43, 0x6f,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x1d, 0x43, 0x6f, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x49, 0x44, 0x22, 0x20, 0x0a, 0x1e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x47, 0x52, 0x50, 0x43, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x0b, 0x50, 0x65, 0x65, 0x72, 0x4c,
+	0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x68, 0x61, 0x72, 0x65, 0x49, 0x44,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x61,
+	0x74, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63,
+	0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x6b, 0x65, 0x77, 0x22, 0x1e, 0x0a, 0x1c, 0x43, 0x6f, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8c, 0x04, 0x0a, 0x1d, 0x43, 0x6f, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x72, 0x61, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x72, 0x61, 0x66, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x65, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65,
+	0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x20, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6c, 0x61, 0x73,
+	0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x31, 0x0a, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x52, 0x53, 0x54, 0x52, 0x0d, 0x6c, 0x61,
+	0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x33, 0x0a, 0x0e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x52, 0x53, 0x54,
+	0x52, 0x0e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x28, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x4c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x69,
+	0x74, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x77, 0x69, 0x74,
+	0x6e, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x13, 0x73, 0x69, 0x67, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x73, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x52,
+	0x53, 0x54, 0x52, 0x13, 0x73, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41,
+	0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x6f, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0x2e, 0x0a, 0x18, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52,
+	0x50, 0x43, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0x25, 0x0a, 0x23, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52,
+	0x50, 0x43, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x83, 0x01, 0x0a, 0x24, 0x43, 0x6f,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73,
+	0x74, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x68, 0x72, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x52, 0x53, 0x54, 0x52, 0x04, 0x68,
+	0x72, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22,
+	0x6c, 0x0a, 0x12, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x41, 0x75, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0x6d, 0x0a,
+	0x24, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a,
+	0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x22, 0x27, 0x0a, 0x25,
+	0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x52, 0x65, 0x63, 0x6f,
+	0x72, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x22, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61,
+	0x75, 0x73, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x2d, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x22,
+	0x25, 0x0a, 0x23, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53,
+	0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x78, 0x0a, 0x26, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1f, 0x0a, 0x04, 0x68, 0x72, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x52, 0x53, 0x54, 0x52, 0x04, 0x68, 0x72, 0x73,
+	0x74, 0x12, 0x2d, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68,
+	0x22, 0x29, 0x0a, 0x27, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43,
+	0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0xf1, 0x0a, 0x0a, 0x0c,
+	0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x12, 0x58, 0x0a, 0x09,
+	0x53, 0x69, 0x67, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53, 0x69,
+	0x67, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47,
+	0x52, 0x50, 0x43, 0x53, 0x69, 0x67, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x97, 0x01, 0x0a, 0x1e, 0x53, 0x65, 0x74, 0x45, 0x70,
+	0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72,
+	0x74, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x12, 0x38, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53, 0x65,
+	0x74, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x50, 0x61, 0x72, 0x74, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53, 0x65, 0x74, 0x45, 0x70, 0x68, 0x65, 0x6d,
+	0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x41,
+	0x6e, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x82, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61,
+	0x6c, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x12, 0x31, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50,
+	0x43, 0x47, 0x65, 0x74, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c, 0x53, 0x65, 0x63,
+	0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x47, 0x52, 0x50, 0x43, 0x47, 0x65, 0x74, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x61, 0x6c,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x50, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x73, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x2c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x09, 0x47, 0x65,
+	0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65, 0x74, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50,
+	0x43, 0x47, 0x65, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x07, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x12,
+	0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x47, 0x52, 0x50, 0x43, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x0a, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43,
+	0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50,
+	0x43, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67,
+	0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x49, 0x0a, 0x04, 0x50, 0x69, 0x6e, 0x67, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x50, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x50, 0x69, 0x6e, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47,
+	0x52, 0x50, 0x43, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x47,
+	0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x11, 0x52, 0x65, 0x63,
+	0x6f, 0x72, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47,
+	0x52, 0x50, 0x43, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43,
+	0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x0f, 0x53,
+	0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x75, 0x73, 0x65, 0x12, 0x29,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47,
+	0x52, 0x50, 0x43, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52, 0x50, 0x43, 0x53, 0x65,
+	0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x50, 0x61, 0x75, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x76, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x43, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2d,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47,
+	0x52, 0x50, 0x43, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x67,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x47, 0x52,
+	0x50, 0x43, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x53, 0x69, 0x67, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42,
+	0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x74,
+	0x72, 0x61, 0x6e, 0x67, 0x65, 0x6c, 0x6f, 0x76, 0x65, 0x2d, 0x76, 0x65, 0x6e, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x2f, 0x68, 0x6f, 0x72, 0x63, 0x72, 0x75, 0x78, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_signer_proto_cosigner_grpc_server_proto_rawDescOnce sync.Once
+	file_signer_proto_cosigner_grpc_server_proto_rawDescData = file_signer_proto_cosigner_grpc_server_proto_rawDesc
+)
+
+func file_signer_proto_cosigner_grpc_server_proto_rawDescGZIP() []byte {
+	file_signer_proto_cosigner_grpc_server_proto_rawDescOnce.Do(func() {
+		file_signer_proto_cosigner_grpc_server_proto_rawDescData = protoimpl.X.CompressGZIP(file_signer_proto_cosigner_grpc_server_proto_rawDescData)
+	})
+	return file_signer_proto_cosigner_grpc_server_proto_rawDescData
+}
+
+var file_signer_proto_cosigner_grpc_server_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_signer_proto_cosigner_grpc_server_proto_goTypes = []interface{}{
+	(*Block)(nil),                         // 0: proto.Block
+	(*CosignerGRPCSignBlockRequest)(nil),  // 1: proto.CosignerGRPCSignBlockRequest
+	(*ConflictingData)(nil),               // 2: proto.ConflictingData
+	(*CosignerGRPCSignBlockResponse)(nil), // 3: proto.CosignerGRPCSignBlockResponse
+	(*EphemeralSecretPart)(nil),           // 4: proto.EphemeralSecretPart
+	(*HRST)(nil),                          // 5: proto.HRST
+	(*CosignerGRPCSetEphemeralSecretPartsAndSignRequest)(nil),  // 6: proto.CosignerGRPCSetEphemeralSecretPartsAndSignRequest
+	(*CosignerGRPCSetEphemeralSecretPartsAndSignResponse)(nil), // 7: proto.CosignerGRPCSetEphemeralSecretPartsAndSignResponse
+	(*CosignerGRPCGetEphemeralSecretPartsRequest)(nil),         // 8: proto.CosignerGRPCGetEphemeralSecretPartsRequest
+	(*CosignerGRPCGetEphemeralSecretPartsResponse)(nil),        // 9: proto.CosignerGRPCGetEphemeralSecretPartsResponse
+	(*CosignerGRPCTransferLeadershipRequest)(nil),              // 10: proto.CosignerGRPCTransferLeadershipRequest
+	(*CosignerGRPCTransferLeadershipResponse)(nil),             // 11: proto.CosignerGRPCTransferLeadershipResponse
+	(*CosignerGRPCGetLeaderRequest)(nil),                       // 12: proto.CosignerGRPCGetLeaderRequest
+	(*CosignerGRPCGetLeaderResponse)(nil),                      // 13: proto.CosignerGRPCGetLeaderResponse
+	(*CosignerGRPCAddPeerRequest)(nil),                         // 14: proto.CosignerGRPCAddPeerRequest
+	(*CosignerGRPCAddPeerResponse)(nil),                        // 15: proto.CosignerGRPCAddPeerResponse
+	(*CosignerGRPCRemovePeerRequest)(nil),                      // 16: proto.CosignerGRPCRemovePeerRequest
+	(*CosignerGRPCRemovePeerResponse)(nil),                     // 17: proto.CosignerGRPCRemovePeerResponse
+	(*PeerLatency)(nil),                                        // 18: proto.PeerLatency
+	(*CosignerGRPCGetStatusRequest)(nil),                       // 19: proto.CosignerGRPCGetStatusRequest
+	(*CosignerGRPCGetStatusResponse)(nil),                      // 20: proto.CosignerGRPCGetStatusResponse
+	(*CosignerGRPCPingRequest)(nil),                            // 21: proto.CosignerGRPCPingRequest
+	(*CosignerGRPCPingResponse)(nil),                           // 22: proto.CosignerGRPCPingResponse
+	(*CosignerGRPCGetLastSignStateRequest)(nil),                // 23: proto.CosignerGRPCGetLastSignStateRequest
+	(*CosignerGRPCGetLastSignStateResponse)(nil),               // 24: proto.CosignerGRPCGetLastSignStateResponse
+	(*ClusterRequestAuth)(nil),                                 // 25: proto.ClusterRequestAuth
+	(*CosignerGRPCRecordAdminActionRequest)(nil),               // 26: proto.CosignerGRPCRecordAdminActionRequest
+	(*CosignerGRPCRecordAdminActionResponse)(nil),              // 27: proto.CosignerGRPCRecordAdminActionResponse
+	(*CosignerGRPCSetClusterPauseRequest)(nil),                 // 28: proto.CosignerGRPCSetClusterPauseRequest
+	(*CosignerGRPCSetClusterPauseResponse)(nil),                // 29: proto.CosignerGRPCSetClusterPauseResponse
+	(*CosignerGRPCSetClusterSignStateRequest)(nil),             // 30: proto.CosignerGRPCSetClusterSignStateRequest
+	(*CosignerGRPCSetClusterSignStateResponse)(nil),            // 31: proto.CosignerGRPCSetClusterSignStateResponse
+}
+var file_signer_proto_cosigner_grpc_server_proto_depIdxs = []int32{
+	0,  // 0: proto.CosignerGRPCSignBlockRequest.block:type_name -> proto.Block
+	4,  // 1: proto.CosignerGRPCSetEphemeralSecretPartsAndSignRequest.encryptedSecrets:type_name -> proto.EphemeralSecretPart
+	5,  // 2: proto.CosignerGRPCSetEphemeralSecretPartsAndSignRequest.hrst:type_name -> proto.HRST
+	5,  // 3: proto.CosignerGRPCGetEphemeralSecretPartsRequest.hrst:type_name -> proto.HRST
+	4,  // 4: proto.CosignerGRPCGetEphemeralSecretPartsResponse.encryptedSecrets:type_name -> proto.EphemeralSecretPart
+	5,  // 5: proto.CosignerGRPCGetStatusResponse.lastSignState:type_name -> proto.HRST
+	5,  // 6: proto.CosignerGRPCGetStatusResponse.shareSignState:type_name -> proto.HRST
+	18, // 7: proto.CosignerGRPCGetStatusResponse.peers:type_name -> proto.PeerLatency
+	5,  // 8: proto.CosignerGRPCGetStatusResponse.signRequestArrivals:type_name -> proto.HRST
+	5,  // 9: proto.CosignerGRPCGetLastSignStateResponse.hrst:type_name -> proto.HRST
+	25, // 10: proto.CosignerGRPCRecordAdminActionRequest.auth:type_name -> proto.ClusterRequestAuth
+	25, // 11: proto.CosignerGRPCSetClusterPauseRequest.auth:type_name -> proto.ClusterRequestAuth
+	5,  // 12: proto.CosignerGRPCSetClusterSignStateRequest.hrst:type_name -> proto.HRST
+	25, // 13: proto.CosignerGRPCSetClusterSignStateRequest.auth:type_name -> proto.ClusterRequestAuth
+	1,  // 14: proto.CosignerGRPC.SignBlock:input_type -> proto.CosignerGRPCSignBlockRequest
+	6,  // 15: proto.CosignerGRPC.SetEphemeralSecretPartsAndSign:input_type -> proto.CosignerGRPCSetEphemeralSecretPartsAndSignRequest
+	8,  // 16: proto.CosignerGRPC.GetEphemeralSecretParts:input_type -> proto.CosignerGRPCGetEphemeralSecretPartsRequest
+	10, // 17: proto.CosignerGRPC.TransferLeadership:input_type -> proto.CosignerGRPCTransferLeadershipRequest
+	12, // 18: proto.CosignerGRPC.GetLeader:input_type -> proto.CosignerGRPCGetLeaderRequest
+	14, // 19: proto.CosignerGRPC.AddPeer:input_type -> proto.CosignerGRPCAddPeerRequest
+	16, // 20: proto.CosignerGRPC.RemovePeer:input_type -> proto.CosignerGRPCRemovePeerRequest
+	19, // 21: proto.CosignerGRPC.GetStatus:input_type -> proto.CosignerGRPCGetStatusRequest
+	21, // 22: proto.CosignerGRPC.Ping:input_type -> proto.CosignerGRPCPingRequest
+	23, // 23: proto.CosignerGRPC.GetLastSignState:input_type -> proto.CosignerGRPCGetLastSignStateRequest
+	26, // 24: proto.CosignerGRPC.RecordAdminAction:input_type -> proto.CosignerGRPCRecordAdminActionRequest
+	28, // 25: proto.CosignerGRPC.SetClusterPause:input_type -> proto.CosignerGRPCSetClusterPauseRequest
+	30, // 26: proto.CosignerGRPC.SetClusterSignState:input_type -> proto.CosignerGRPCSetClusterSignStateRequest
+	3,  // 27: proto.CosignerGRPC.SignBlock:output_type -> proto.CosignerGRPCSignBlockResponse
+	7,  // 28: proto.CosignerGRPC.SetEphemeralSecretPartsAndSign:output_type -> proto.CosignerGRPCSetEphemeralSecretPartsAndSignResponse
+	9,  // 29: proto.CosignerGRPC.GetEphemeralSecretParts:output_type -> proto.CosignerGRPCGetEphemeralSecretPartsResponse
+	11, // 30: proto.CosignerGRPC.TransferLeadership:output_type -> proto.CosignerGRPCTransferLeadershipResponse
+	13, // 31: proto.CosignerGRPC.GetLeader:output_type -> proto.CosignerGRPCGetLeaderResponse
+	15, // 32: proto.CosignerGRPC.AddPeer:output_type -> proto.CosignerGRPCAddPeerResponse
+	17, // 33: proto.CosignerGRPC.RemovePeer:output_type -> proto.CosignerGRPCRemovePeerResponse
+	20, // 34: proto.CosignerGRPC.GetStatus:output_type -> proto.CosignerGRPCGetStatusResponse
+	22, // 35: proto.CosignerGRPC.Ping:output_type -> proto.CosignerGRPCPingResponse
+	24, // 36: proto.CosignerGRPC.GetLastSignState:output_type -> proto.CosignerGRPCGetLastSignStateResponse
+	27, // 37: proto.CosignerGRPC.RecordAdminAction:output_type -> proto.CosignerGRPCRecordAdminActionResponse
+	29, // 38: proto.CosignerGRPC.SetClusterPause:output_type -> proto.CosignerGRPCSetClusterPauseResponse
+	31, // 39: proto.CosignerGRPC.SetClusterSignState:output_type -> proto.CosignerGRPCSetClusterSignStateResponse
+	27, // [27:40] is the sub-list for method output_type
+	14, // [14:27] is the sub-list for method input_type
+	14, // [14:14] is the sub-list for extension type_name
+	14, // [14:14] is the sub-list for extension extendee
+	0,  // [0:14] is the sub-list for field type_name
+}
+
+func init() { file_signer_proto_cosigner_grpc_server_proto_init() }
+func file_signer_proto_cosigner_grpc_server_proto_init() {
+	if File_signer_proto_cosigner_grpc_server_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Block); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCSignBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConflictingData); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -992,7 +2278,7 @@ func file_signer_proto_cosigner_grpc_server_proto_init() {
 			}
 		}
 		file_signer_proto_cosigner_grpc_server_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EphemeralSecretPart); i {
+			switch v := v.(*CosignerGRPCSignBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1004,7 +2290,7 @@ func file_signer_proto_cosigner_grpc_server_proto_init() {
 			}
 		}
 		file_signer_proto_cosigner_grpc_server_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HRST); i {
+			switch v := v.(*EphemeralSecretPart); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1016,7 +2302,7 @@ func file_signer_proto_cosigner_grpc_server_proto_init() {
 			}
 		}
 		file_signer_proto_cosigner_grpc_server_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CosignerGRPCSetEphemeralSecretPartsAndSignRequest); i {
+			switch v := v.(*HRST); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1028,7 +2314,7 @@ func file_signer_proto_cosigner_grpc_server_proto_init() {
 			}
 		}
 		file_signer_proto_cosigner_grpc_server_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CosignerGRPCSetEphemeralSecretPartsAndSignResponse); i {
+			switch v := v.(*CosignerGRPCSetEphemeralSecretPartsAndSignRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1040,7 +2326,7 @@ func file_signer_proto_cosigner_grpc_server_proto_init() {
 			}
 		}
 		file_signer_proto_cosigner_grpc_server_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CosignerGRPCGetEphemeralSecretPartsRequest); i {
+			switch v := v.(*CosignerGRPCSetEphemeralSecretPartsAndSignResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1052,7 +2338,7 @@ func file_signer_proto_cosigner_grpc_server_proto_init() {
 			}
 		}
 		file_signer_proto_cosigner_grpc_server_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CosignerGRPCGetEphemeralSecretPartsResponse); i {
+			switch v := v.(*CosignerGRPCGetEphemeralSecretPartsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1064,7 +2350,7 @@ func file_signer_proto_cosigner_grpc_server_proto_init() {
 			}
 		}
 		file_signer_proto_cosigner_grpc_server_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CosignerGRPCTransferLeadershipRequest); i {
+			switch v := v.(*CosignerGRPCGetEphemeralSecretPartsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1076,7 +2362,7 @@ func file_signer_proto_cosigner_grpc_server_proto_init() {
 			}
 		}
 		file_signer_proto_cosigner_grpc_server_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CosignerGRPCTransferLeadershipResponse); i {
+			switch v := v.(*CosignerGRPCTransferLeadershipRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1088,7 +2374,7 @@ func file_signer_proto_cosigner_grpc_server_proto_init() {
 			}
 		}
 		file_signer_proto_cosigner_grpc_server_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CosignerGRPCGetLeaderRequest); i {
+			switch v := v.(*CosignerGRPCTransferLeadershipResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1100,6 +2386,18 @@ func file_signer_proto_cosigner_grpc_server_proto_init() {
 			}
 		}
 		file_signer_proto_cosigner_grpc_server_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCGetLeaderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CosignerGRPCGetLeaderResponse); i {
 			case 0:
 				return &v.state
@@ -1111,6 +2409,222 @@ func file_signer_proto_cosigner_grpc_server_proto_init() {
 				return nil
 			}
 		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCAddPeerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCAddPeerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCRemovePeerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCRemovePeerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PeerLatency); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCGetStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCGetStatusResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCPingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCPingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCGetLastSignStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCGetLastSignStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClusterRequestAuth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCRecordAdminActionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCRecordAdminActionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCSetClusterPauseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCSetClusterPauseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCSetClusterSignStateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_signer_proto_cosigner_grpc_server_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CosignerGRPCSetClusterSignStateResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1118,7 +2632,7 @@ func file_signer_proto_cosigner_grpc_server_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_signer_proto_cosigner_grpc_server_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -27,6 +27,14 @@ type CosignerGRPCClient interface {
 	GetEphemeralSecretParts(ctx context.Context, in *CosignerGRPCGetEphemeralSecretPartsRequest, opts ...grpc.CallOption) (*CosignerGRPCGetEphemeralSecretPartsResponse, error)
 	TransferLeadership(ctx context.Context, in *CosignerGRPCTransferLeadershipRequest, opts ...grpc.CallOption) (*CosignerGRPCTransferLeadershipResponse, error)
 	GetLeader(ctx context.Context, in *CosignerGRPCGetLeaderRequest, opts ...grpc.CallOption) (*CosignerGRPCGetLeaderResponse, error)
+	AddPeer(ctx context.Context, in *CosignerGRPCAddPeerRequest, opts ...grpc.CallOption) (*CosignerGRPCAddPeerResponse, error)
+	RemovePeer(ctx context.Context, in *CosignerGRPCRemovePeerRequest, opts ...grpc.CallOption) (*CosignerGRPCRemovePeerResponse, error)
+	GetStatus(ctx context.Context, in *CosignerGRPCGetStatusRequest, opts ...grpc.CallOption) (*CosignerGRPCGetStatusResponse, error)
+	Ping(ctx context.Context, in *CosignerGRPCPingRequest, opts ...grpc.CallOption) (*CosignerGRPCPingResponse, error)
+	GetLastSignState(ctx context.Context, in *CosignerGRPCGetLastSignStateRequest, opts ...grpc.CallOption) (*CosignerGRPCGetLastSignStateResponse, error)
+	RecordAdminAction(ctx context.Context, in *CosignerGRPCRecordAdminActionRequest, opts ...grpc.CallOption) (*CosignerGRPCRecordAdminActionResponse, error)
+	SetClusterPause(ctx context.Context, in *CosignerGRPCSetClusterPauseRequest, opts ...grpc.CallOption) (*CosignerGRPCSetClusterPauseResponse, error)
+	SetClusterSignState(ctx context.Context, in *CosignerGRPCSetClusterSignStateRequest, opts ...grpc.CallOption) (*CosignerGRPCSetClusterSignStateResponse, error)
 }
 
 type cosignerGRPCClient struct {
@@ -82,6 +90,78 @@ func (c *cosignerGRPCClient) GetLeader(ctx context.Context, in *CosignerGRPCGetL
 	return out, nil
 }
 
+func (c *cosignerGRPCClient) AddPeer(ctx context.Context, in *CosignerGRPCAddPeerRequest, opts ...grpc.CallOption) (*CosignerGRPCAddPeerResponse, error) {
+	out := new(CosignerGRPCAddPeerResponse)
+	err := c.cc.Invoke(ctx, "/proto.CosignerGRPC/AddPeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cosignerGRPCClient) RemovePeer(ctx context.Context, in *CosignerGRPCRemovePeerRequest, opts ...grpc.CallOption) (*CosignerGRPCRemovePeerResponse, error) {
+	out := new(CosignerGRPCRemovePeerResponse)
+	err := c.cc.Invoke(ctx, "/proto.CosignerGRPC/RemovePeer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cosignerGRPCClient) GetStatus(ctx context.Context, in *CosignerGRPCGetStatusRequest, opts ...grpc.CallOption) (*CosignerGRPCGetStatusResponse, error) {
+	out := new(CosignerGRPCGetStatusResponse)
+	err := c.cc.Invoke(ctx, "/proto.CosignerGRPC/GetStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cosignerGRPCClient) Ping(ctx context.Context, in *CosignerGRPCPingRequest, opts ...grpc.CallOption) (*CosignerGRPCPingResponse, error) {
+	out := new(CosignerGRPCPingResponse)
+	err := c.cc.Invoke(ctx, "/proto.CosignerGRPC/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cosignerGRPCClient) GetLastSignState(ctx context.Context, in *CosignerGRPCGetLastSignStateRequest, opts ...grpc.CallOption) (*CosignerGRPCGetLastSignStateResponse, error) {
+	out := new(CosignerGRPCGetLastSignStateResponse)
+	err := c.cc.Invoke(ctx, "/proto.CosignerGRPC/GetLastSignState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cosignerGRPCClient) RecordAdminAction(ctx context.Context, in *CosignerGRPCRecordAdminActionRequest, opts ...grpc.CallOption) (*CosignerGRPCRecordAdminActionResponse, error) {
+	out := new(CosignerGRPCRecordAdminActionResponse)
+	err := c.cc.Invoke(ctx, "/proto.CosignerGRPC/RecordAdminAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cosignerGRPCClient) SetClusterPause(ctx context.Context, in *CosignerGRPCSetClusterPauseRequest, opts ...grpc.CallOption) (*CosignerGRPCSetClusterPauseResponse, error) {
+	out := new(CosignerGRPCSetClusterPauseResponse)
+	err := c.cc.Invoke(ctx, "/proto.CosignerGRPC/SetClusterPause", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cosignerGRPCClient) SetClusterSignState(ctx context.Context, in *CosignerGRPCSetClusterSignStateRequest, opts ...grpc.CallOption) (*CosignerGRPCSetClusterSignStateResponse, error) {
+	out := new(CosignerGRPCSetClusterSignStateResponse)
+	err := c.cc.Invoke(ctx, "/proto.CosignerGRPC/SetClusterSignState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CosignerGRPCServer is the server API for CosignerGRPC service.
 // All implementations must embed UnimplementedCosignerGRPCServer
 // for forward compatibility
@@ -91,6 +171,14 @@ type CosignerGRPCServer interface {
 	GetEphemeralSecretParts(context.Context, *CosignerGRPCGetEphemeralSecretPartsRequest) (*CosignerGRPCGetEphemeralSecretPartsResponse, error)
 	TransferLeadership(context.Context, *CosignerGRPCTransferLeadershipRequest) (*CosignerGRPCTransferLeadershipResponse, error)
 	GetLeader(context.Context, *CosignerGRPCGetLeaderRequest) (*CosignerGRPCGetLeaderResponse, error)
+	AddPeer(context.Context, *CosignerGRPCAddPeerRequest) (*CosignerGRPCAddPeerResponse, error)
+	RemovePeer(context.Context, *CosignerGRPCRemovePeerRequest) (*CosignerGRPCRemovePeerResponse, error)
+	GetStatus(context.Context, *CosignerGRPCGetStatusRequest) (*CosignerGRPCGetStatusResponse, error)
+	Ping(context.Context, *CosignerGRPCPingRequest) (*CosignerGRPCPingResponse, error)
+	GetLastSignState(context.Context, *CosignerGRPCGetLastSignStateRequest) (*CosignerGRPCGetLastSignStateResponse, error)
+	RecordAdminAction(context.Context, *CosignerGRPCRecordAdminActionRequest) (*CosignerGRPCRecordAdminActionResponse, error)
+	SetClusterPause(context.Context, *CosignerGRPCSetClusterPauseRequest) (*CosignerGRPCSetClusterPauseResponse, error)
+	SetClusterSignState(context.Context, *CosignerGRPCSetClusterSignStateRequest) (*CosignerGRPCSetClusterSignStateResponse, error)
 	mustEmbedUnimplementedCosignerGRPCServer()
 }
 
@@ -113,6 +201,30 @@ func (UnimplementedCosignerGRPCServer) TransferLeadership(context.Context, *Cosi
 func (UnimplementedCosignerGRPCServer) GetLeader(context.Context, *CosignerGRPCGetLeaderRequest) (*CosignerGRPCGetLeaderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLeader not implemented")
 }
+func (UnimplementedCosignerGRPCServer) AddPeer(context.Context, *CosignerGRPCAddPeerRequest) (*CosignerGRPCAddPeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPeer not implemented")
+}
+func (UnimplementedCosignerGRPCServer) RemovePeer(context.Context, *CosignerGRPCRemovePeerRequest) (*CosignerGRPCRemovePeerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePeer not implemented")
+}
+func (UnimplementedCosignerGRPCServer) GetStatus(context.Context, *CosignerGRPCGetStatusRequest) (*CosignerGRPCGetStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
+}
+func (UnimplementedCosignerGRPCServer) Ping(context.Context, *CosignerGRPCPingRequest) (*CosignerGRPCPingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedCosignerGRPCServer) GetLastSignState(context.Context, *CosignerGRPCGetLastSignStateRequest) (*CosignerGRPCGetLastSignStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLastSignState not implemented")
+}
+func (UnimplementedCosignerGRPCServer) RecordAdminAction(context.Context, *CosignerGRPCRecordAdminActionRequest) (*CosignerGRPCRecordAdminActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordAdminAction not implemented")
+}
+func (UnimplementedCosignerGRPCServer) SetClusterPause(context.Context, *CosignerGRPCSetClusterPauseRequest) (*CosignerGRPCSetClusterPauseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClusterPause not implemented")
+}
+func (UnimplementedCosignerGRPCServer) SetClusterSignState(context.Context, *CosignerGRPCSetClusterSignStateRequest) (*CosignerGRPCSetClusterSignStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClusterSignState not implemented")
+}
 func (UnimplementedCosignerGRPCServer) mustEmbedUnimplementedCosignerGRPCServer() {}
 
 // UnsafeCosignerGRPCServer may be embedded to opt out of forward compatibility for this service.
@@ -216,6 +328,150 @@ func _CosignerGRPC_GetLeader_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CosignerGRPC_AddPeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CosignerGRPCAddPeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CosignerGRPCServer).AddPeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CosignerGRPC/AddPeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerGRPCServer).AddPeer(ctx, req.(*CosignerGRPCAddPeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CosignerGRPC_RemovePeer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CosignerGRPCRemovePeerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CosignerGRPCServer).RemovePeer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CosignerGRPC/RemovePeer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerGRPCServer).RemovePeer(ctx, req.(*CosignerGRPCRemovePeerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CosignerGRPC_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CosignerGRPCGetStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CosignerGRPCServer).GetStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CosignerGRPC/GetStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerGRPCServer).GetStatus(ctx, req.(*CosignerGRPCGetStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CosignerGRPC_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CosignerGRPCPingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CosignerGRPCServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CosignerGRPC/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerGRPCServer).Ping(ctx, req.(*CosignerGRPCPingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CosignerGRPC_GetLastSignState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CosignerGRPCGetLastSignStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CosignerGRPCServer).GetLastSignState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CosignerGRPC/GetLastSignState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerGRPCServer).GetLastSignState(ctx, req.(*CosignerGRPCGetLastSignStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CosignerGRPC_RecordAdminAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CosignerGRPCRecordAdminActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CosignerGRPCServer).RecordAdminAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CosignerGRPC/RecordAdminAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerGRPCServer).RecordAdminAction(ctx, req.(*CosignerGRPCRecordAdminActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CosignerGRPC_SetClusterPause_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CosignerGRPCSetClusterPauseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CosignerGRPCServer).SetClusterPause(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CosignerGRPC/SetClusterPause",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerGRPCServer).SetClusterPause(ctx, req.(*CosignerGRPCSetClusterPauseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CosignerGRPC_SetClusterSignState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CosignerGRPCSetClusterSignStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CosignerGRPCServer).SetClusterSignState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.CosignerGRPC/SetClusterSignState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignerGRPCServer).SetClusterSignState(ctx, req.(*CosignerGRPCSetClusterSignStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // CosignerGRPC_ServiceDesc is the grpc.ServiceDesc for CosignerGRPC service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -243,6 +499,38 @@ var CosignerGRPC_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLeader",
 			Handler:    _CosignerGRPC_GetLeader_Handler,
 		},
+		{
+			MethodName: "AddPeer",
+			Handler:    _CosignerGRPC_AddPeer_Handler,
+		},
+		{
+			MethodName: "RemovePeer",
+			Handler:    _CosignerGRPC_RemovePeer_Handler,
+		},
+		{
+			MethodName: "GetStatus",
+			Handler:    _CosignerGRPC_GetStatus_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _CosignerGRPC_Ping_Handler,
+		},
+		{
+			MethodName: "GetLastSignState",
+			Handler:    _CosignerGRPC_GetLastSignState_Handler,
+		},
+		{
+			MethodName: "RecordAdminAction",
+			Handler:    _CosignerGRPC_RecordAdminAction_Handler,
+		},
+		{
+			MethodName: "SetClusterPause",
+			Handler:    _CosignerGRPC_SetClusterPause_Handler,
+		},
+		{
+			MethodName: "SetClusterSignState",
+			Handler:    _CosignerGRPC_SetClusterSignState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "signer/proto/cosigner_grpc_server.proto",
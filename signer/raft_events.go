@@ -3,6 +3,7 @@ package signer
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	proto "github.com/strangelove-ventures/horcrux/signer/proto"
@@ -11,29 +12,150 @@ import (
 )
 
 const (
-	raftEventLSS = "LSS"
+	raftEventLSS          = "LSS"
+	raftEventPeers        = "Peers"
+	raftEventAdminAction  = "AdminAction"
+	raftEventPause        = "Pause"
+	raftEventChainUpgrade = "ChainUpgrade"
+	raftEventSignState    = "SignState"
 )
 
+// lssEvent is the last sign state replicated through raft with the chain it was signed for
+type lssEvent struct {
+	SignStateConsensus
+	ChainID string `json:",omitempty"`
+}
+
 func (f *fsm) getEventHandler(key string) func(string) {
 	return map[string]func(string){
-		raftEventLSS: f.handleLSSEvent,
+		raftEventLSS:          f.handleLSSEvent,
+		raftEventPeers:        f.handlePeersEvent,
+		raftEventAdminAction:  f.handleAdminActionEvent,
+		raftEventPause:        f.handlePauseEvent,
+		raftEventChainUpgrade: f.handleChainUpgradeEvent,
+		raftEventSignState:    f.handleSignStateEvent,
 	}[key]
 }
 
 func (f *fsm) shouldRetain(key string) bool {
-	// Last sign state handled as events only
-	return key != raftEventLSS
+	// Last sign state, peers, admin actions, pauses, chain upgrades and sign states set by the operator
+	// handled as events only
+	switch key {
+	case raftEventLSS, raftEventPeers, raftEventAdminAction, raftEventPause, raftEventChainUpgrade,
+		raftEventSignState:
+		return false
+	}
+	return true
 }
 
 func (f *fsm) handleLSSEvent(value string) {
-	lss := &SignStateConsensus{}
-	err := json.Unmarshal([]byte(value), lss)
+	event := &lssEvent{}
+	err := json.Unmarshal([]byte(value), event)
 	if err != nil {
 		f.logger.Error("LSS Unmarshal Error", err.Error())
 		return
 	}
+	if chainID := f.Upgrade.ChainID(); event.ChainID != "" && chainID != "" && event.ChainID != chainID {
+		// replayed by raft from before a chain upgrade
+		return
+	}
+	lss := &event.SignStateConsensus
+	if (*RaftStore)(f).isWitness() {
+		_ = f.witnessSignState.Save(*lss, &f.witnessSignStateMutex, true)
+		return
+	}
 	_ = f.thresholdValidator.SaveLastSignedState(*lss)
 	_ = f.cosigner.SaveLastSignedState(*lss)
+
+	// every cosigner resumes at the same entry of the raft log
+	if cp := f.Pause.ClusterPause(); cp.Paused && cp.resumesAt(lss.Height) {
+		f.logger.Info("Resuming signing of the cluster", "height", lss.Height, "resume_height", cp.ResumeHeight)
+		(*RaftStore)(f).applyClusterPause(ClusterPause{})
+	}
+}
+
+func (f *fsm) handlePauseEvent(value string) {
+	var cp ClusterPause
+	if err := json.Unmarshal([]byte(value), &cp); err != nil {
+		f.logger.Error("Pause Unmarshal Error", err.Error())
+		return
+	}
+	if cp.Paused {
+		f.logger.Info("Pausing signing of the cluster", "reason", cp.Reason, "resume_height", cp.ResumeHeight)
+	} else {
+		f.logger.Info("Resuming signing of the cluster")
+	}
+	(*RaftStore)(f).applyClusterPause(cp)
+}
+
+func (f *fsm) handleChainUpgradeEvent(value string) {
+	var plan UpgradePlan
+	if err := json.Unmarshal([]byte(value), &plan); err != nil {
+		f.logger.Error("Chain upgrade Unmarshal Error", err.Error())
+		return
+	}
+	(*RaftStore)(f).applyChainUpgrade(plan)
+}
+
+func (f *fsm) handleSignStateEvent(value string) {
+	event := &lssEvent{}
+	if err := json.Unmarshal([]byte(value), event); err != nil {
+		f.logger.Error("Sign state Unmarshal Error", err.Error())
+		return
+	}
+	if chainID := f.Upgrade.ChainID(); event.ChainID != "" && chainID != "" && event.ChainID != chainID {
+		// replayed by raft from before a chain upgrade
+		return
+	}
+	(*RaftStore)(f).applySignState(event.SignStateConsensus)
+}
+
+func (f *fsm) handlePeersEvent(value string) {
+	var members []CosignerConfig
+	err := json.Unmarshal([]byte(value), &members)
+	if err != nil {
+		f.logger.Error("Peers Unmarshal Error", err.Error())
+		return
+	}
+
+	ids := make([]int, 0, len(members))
+	peers := make([]Cosigner, 0, len(members))
+	removed := true
+	for _, member := range members {
+		ids = append(ids, member.ID)
+		if fmt.Sprint(member.ID) == f.NodeID {
+			removed = false
+			continue
+		}
+		peers = append(peers, NewRemoteCosigner(member.ID, member.Address))
+	}
+
+	// Replayed by raft on restart and when joining, the last peers event of the log decides.
+	// The raft configuration of a removed cosigner is stale, it must not sign until it is added back.
+	if f.Pause != nil {
+		f.Pause.SetRemoved(removed)
+	}
+	if removed {
+		f.logger.Error("This cosigner was removed from the cluster, signing is paused until it is added back")
+		return
+	}
+
+	if f.cosigner != nil {
+		if err := f.cosigner.UpdatePeers(ids); err != nil {
+			f.logger.Error("Failed to update cosigner peers", "error", err)
+			return
+		}
+	}
+	if f.thresholdValidator != nil {
+		f.thresholdValidator.SetPeers(peers)
+	}
+	(*RaftStore)(f).setPeers(peers)
+
+	f.logger.Info("Cosigner peers changed", "peers", len(peers))
+
+	if f.OnPeersChanged != nil {
+		f.OnPeersChanged(members)
+	}
 }
 
 func (s *RaftStore) getLeaderGRPCClient() (proto.CosignerGRPCClient, *grpc.ClientConn, error) {
@@ -65,8 +187,9 @@ func (s *RaftStore) LeaderSignBlock(req CosignerSignBlockRequest) (*CosignerSign
 	context, cancelFunc := getContext()
 	defer cancelFunc()
 	res, err := client.SignBlock(context, &proto.CosignerGRPCSignBlockRequest{
-		ChainID: req.ChainID,
-		Block:   req.Block.toProto(),
+		ChainID:  req.ChainID,
+		Block:    req.Block.toProto(),
+		SourceID: int32(s.cosigner.GetID()),
 	})
 	if err != nil {
 		return nil, err
@@ -8,6 +8,7 @@
 package signer
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -15,6 +16,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"sync"
 	"time"
 
@@ -51,6 +53,35 @@ type RaftStore struct {
 	RaftTimeout time.Duration
 	Peers       []Cosigner
 
+	// Witnesses are raft voters that hold no key share and do not take part in signing
+	Witnesses []CosignerConfig
+
+	// Version of horcrux reported in the cluster status
+	Version string
+
+	// LeaderPlacement enables moving the leadership to the cosigner that is expected to sign the fastest
+	LeaderPlacement bool
+
+	// LeaderPriorities are the leader priorities by share ID, including this cosigner.
+	// Cosigners without a configured priority have priority 0.
+	LeaderPriorities map[int]int
+
+	// OnPeersChanged is called on every node after the cluster membership
+	// has been changed at runtime with the full list of cosigners in the cluster.
+	OnPeersChanged func(cosigners []CosignerConfig)
+
+	// AdminAuditFile receives the admin actions replicated through raft, not written if empty
+	AdminAuditFile string
+
+	// Pause receives the cluster pause replicated through raft, which is persisted to PauseFile
+	Pause     *SigningPause
+	PauseFile string
+
+	// Upgrade switches this node to the new chain when a chain upgrade is replicated through raft
+	Upgrade *ChainUpgrade
+
+	peersMu sync.RWMutex
+
 	mu sync.Mutex
 	m  map[string]string // The key-value store for the system.
 
@@ -59,6 +90,12 @@ type RaftStore struct {
 	logger             log.Logger
 	cosigner           *LocalCosigner
 	thresholdValidator *ThresholdValidator
+
+	// witnessSignState holds the replicated last sign state on witness nodes
+	witnessSignState      *SignState
+	witnessSignStateMutex sync.Mutex
+
+	leaderPlacement *leaderPlacement
 }
 
 // New returns a new Store.
@@ -80,6 +117,31 @@ func NewRaftStore(
 	return cosignerRaftStore
 }
 
+// NewWitnessRaftStore returns a store for a witness node. Witnesses hold no key share,
+// they only vote in raft elections and replicate the last sign state into signState.
+func NewWitnessRaftStore(
+	nodeID string, directory string, bindAddress string, timeout time.Duration,
+	logger log.Logger, signState *SignState, raftPeers []Cosigner) *RaftStore {
+	witnessRaftStore := &RaftStore{
+		NodeID:           nodeID,
+		RaftDir:          directory,
+		RaftBind:         bindAddress,
+		RaftTimeout:      timeout,
+		m:                make(map[string]string),
+		logger:           logger,
+		witnessSignState: signState,
+		Peers:            raftPeers,
+	}
+
+	witnessRaftStore.BaseService = *service.NewBaseService(logger, "WitnessRaftStore", witnessRaftStore)
+	return witnessRaftStore
+}
+
+// isWitness returns true if this node holds no key share
+func (s *RaftStore) isWitness() bool {
+	return s.cosigner == nil
+}
+
 func (s *RaftStore) SetThresholdValidator(thresholdValidator *ThresholdValidator) {
 	s.thresholdValidator = thresholdValidator
 }
@@ -104,6 +166,8 @@ func (s *RaftStore) init() error {
 		cosigner:           s.cosigner,
 		thresholdValidator: s.thresholdValidator,
 		raftStore:          s,
+		logger:             s.logger,
+		version:            s.Version,
 	})
 	transportManager.Register(grpcServer)
 	leaderhealth.Setup(s.raft, grpcServer, []string{"Leader"})
@@ -144,6 +208,12 @@ func (s *RaftStore) Open() (*gRPCTransport.Manager, error) {
 	config.LocalID = raft.ServerID(s.NodeID)
 	config.LogLevel = "ERROR"
 
+	// lower priority cosigners wait longer before campaigning
+	if rank := s.leaderPriorityRank(); rank > 0 {
+		config.HeartbeatTimeout *= time.Duration(1 + rank)
+		config.ElectionTimeout *= time.Duration(1 + rank)
+	}
+
 	// Create the snapshot store. This allows the Raft to truncate the log.
 	snapshots, err := raft.NewFileSnapshotStore(s.RaftDir, retainSnapshotCount, os.Stderr)
 	if err != nil {
@@ -185,17 +255,75 @@ func (s *RaftStore) Open() (*gRPCTransport.Manager, error) {
 			},
 		},
 	}
-	for _, peer := range s.Peers {
+	for _, peer := range s.getPeers() {
 		configuration.Servers = append(configuration.Servers, raft.Server{
 			ID:      raft.ServerID(fmt.Sprint(peer.GetID())),
 			Address: raft.ServerAddress(p2pURLToRaftAddress(peer.GetAddress())),
 		})
 	}
+	for _, witness := range s.Witnesses {
+		if fmt.Sprint(witness.ID) == s.NodeID {
+			continue
+		}
+		configuration.Servers = append(configuration.Servers, raft.Server{
+			ID:      raft.ServerID(fmt.Sprint(witness.ID)),
+			Address: raft.ServerAddress(p2pURLToRaftAddress(witness.Address)),
+		})
+	}
 	s.raft.BootstrapCluster(configuration)
 
+	observations := make(chan raft.Observation, 16)
+	s.raft.RegisterObserver(raft.NewObserver(observations, false, func(o *raft.Observation) bool {
+		_, ok := o.Data.(raft.LeaderObservation)
+		return ok
+	}))
+	go s.alertLeaderChanges(observations)
+
+	if s.isWitness() {
+		go s.handOffWitnessLeadership()
+	} else {
+		if s.LeaderPlacement {
+			s.leaderPlacement = newLeaderPlacement()
+			go s.runLeaderPlacement()
+		}
+		if len(s.LeaderPriorities) > 0 {
+			go s.runLeaderPriority()
+		}
+	}
+
 	return transportManager, nil
 }
 
+// alertLeaderChanges fires an alert whenever the raft leader changes or the cluster loses its leader
+func (s *RaftStore) alertLeaderChanges(observations <-chan raft.Observation) {
+	for {
+		select {
+		case <-s.Quit():
+			return
+		case o := <-observations:
+			leader := o.Data.(raft.LeaderObservation)
+			if leader.LeaderID == "" {
+				fireAlert(Alert{
+					Event:    AlertRaftLeaderChange,
+					Severity: AlertWarning,
+					Summary:  "Raft cluster has no leader",
+					Key:      "no-leader",
+				})
+				continue
+			}
+			fireAlert(Alert{
+				Event:    AlertRaftLeaderChange,
+				Severity: AlertInfo,
+				Summary:  fmt.Sprintf("Raft leader changed to cosigner %s", leader.LeaderID),
+				Details: map[string]string{
+					"leader_id":      string(leader.LeaderID),
+					"leader_address": string(leader.LeaderAddr),
+				},
+			})
+		}
+	}
+}
+
 // Get returns the value for the given key.
 func (s *RaftStore) Get(key string) (string, error) {
 	s.mu.Lock()
@@ -289,6 +417,201 @@ func (s *RaftStore) GetLeader() raft.ServerAddress {
 	return s.raft.Leader()
 }
 
+// raftStatus returns the raft state of this node along with the time since the last contact
+// with the leader. The time since last contact is 0 for the leader and -1 if there never was contact.
+func (s *RaftStore) raftStatus() (state string, term, commitIndex, appliedIndex uint64, lastContact time.Duration) {
+	stats := s.raft.Stats()
+	term, _ = strconv.ParseUint(stats["term"], 10, 64)
+	commitIndex, _ = strconv.ParseUint(stats["commit_index"], 10, 64)
+
+	state = s.raft.State().String()
+	switch {
+	case s.raft.State() == raft.Leader:
+		lastContact = 0
+	case s.raft.LastContact().IsZero():
+		lastContact = -1
+	default:
+		lastContact = time.Since(s.raft.LastContact())
+	}
+	return state, term, commitIndex, s.raft.AppliedIndex(), lastContact
+}
+
+// handOffWitnessLeadership transfers the raft leadership to a signing cosigner whenever
+// this witness is elected, since a witness can not coordinate the signing rounds.
+func (s *RaftStore) handOffWitnessLeadership() {
+	for isLeader := range s.raft.LeaderCh() {
+		if !isLeader {
+			continue
+		}
+		s.logger.Info("Witness elected as raft leader, transferring leadership to a signing cosigner")
+		for _, peer := range s.peersByLeaderPriority() {
+			err := s.raft.LeadershipTransferToServer(
+				raft.ServerID(fmt.Sprint(peer.GetID())),
+				raft.ServerAddress(p2pURLToRaftAddress(peer.GetAddress())),
+			).Error()
+			if err == nil {
+				break
+			}
+			s.logger.Error("Failed to transfer leadership", "id", peer.GetID(), "error", err)
+		}
+	}
+}
+
+// Drain waits for the sign requests in progress, transfers the raft leadership to a healthy
+// peer if this node is the leader, and synchronously flushes the sign state to disk.
+// Implements Drainer interface
+func (s *RaftStore) Drain(ctx context.Context) error {
+	if s.thresholdValidator != nil {
+		if err := s.thresholdValidator.waitForSignBlocks(ctx); err != nil {
+			s.logger.Error("Failed to wait for sign requests in progress", "error", err)
+		}
+	}
+
+	if s.raft != nil && s.raft.State() == raft.Leader {
+		if err := s.handOffLeadership(ctx); err != nil {
+			s.logger.Error("Failed to transfer leadership before shutdown", "error", err)
+		}
+	}
+
+	if s.isWitness() {
+		s.witnessSignState.Flush(&s.witnessSignStateMutex)
+		return nil
+	}
+	if s.thresholdValidator != nil {
+		s.thresholdValidator.flushSignState()
+	}
+	s.cosigner.flushSignState()
+	return nil
+}
+
+// handOffLeadership transfers the raft leadership to the healthy peer with the highest leader priority.
+// If no peer is healthy, raft picks the most up to date voter.
+func (s *RaftStore) handOffLeadership(ctx context.Context) error {
+	transfer := func() raft.Future {
+		for _, peer := range s.peersByLeaderPriority() {
+			status, err := NewRemoteCosigner(peer.GetID(), peer.GetAddress()).GetStatus()
+			if err != nil || !s.isHealthyFollower(status) {
+				continue
+			}
+			s.logger.Info("Transferring leadership before shutdown", "id", peer.GetID())
+			return s.raft.LeadershipTransferToServer(
+				raft.ServerID(fmt.Sprint(peer.GetID())),
+				raft.ServerAddress(p2pURLToRaftAddress(peer.GetAddress())),
+			)
+		}
+		s.logger.Info("No healthy peer found, transferring leadership to any voter before shutdown")
+		return s.raft.LeadershipTransfer()
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- transfer().Error()
+	}()
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// witnessLastSignedHRS returns the height, round and step of the last sign state replicated to a witness
+func (s *RaftStore) witnessLastSignedHRS() HRSTKey {
+	s.witnessSignStateMutex.Lock()
+	defer s.witnessSignStateMutex.Unlock()
+	return HRSTKey{
+		Height: s.witnessSignState.Height,
+		Round:  s.witnessSignState.Round,
+		Step:   s.witnessSignState.Step,
+	}
+}
+
+func (s *RaftStore) getPeers() []Cosigner {
+	s.peersMu.RLock()
+	defer s.peersMu.RUnlock()
+	return s.Peers
+}
+
+func (s *RaftStore) setPeers(peers []Cosigner) {
+	s.peersMu.Lock()
+	defer s.peersMu.Unlock()
+	s.Peers = peers
+}
+
+// members returns the configuration of all cosigners in the cluster, including ourselves.
+func (s *RaftStore) members() []CosignerConfig {
+	id, _ := strconv.Atoi(s.NodeID)
+	members := []CosignerConfig{{ID: id, Address: s.RaftBind}}
+	for _, peer := range s.getPeers() {
+		members = append(members, CosignerConfig{ID: peer.GetID(), Address: peer.GetAddress()})
+	}
+	return members
+}
+
+// AddPeer adds the cosigner with the given share ID as a raft voter and replicates
+// the new list of cosigners to the cluster. If a cosigner with the same share ID
+// is already a member, its address is replaced. Must be called on the leader.
+func (s *RaftStore) AddPeer(shareID int, p2pAddr string) error {
+	if s.raft.State() != raft.Leader || s.isWitness() {
+		return fmt.Errorf("not leader")
+	}
+	if fmt.Sprint(shareID) == s.NodeID {
+		return fmt.Errorf("cosigner %d is the raft leader", shareID)
+	}
+	if shareID < 1 || shareID > int(s.cosigner.total) {
+		return fmt.Errorf("share ID %d is out of range, must be between 1 and %d", shareID, s.cosigner.total)
+	}
+
+	if err := s.Join(fmt.Sprint(shareID), p2pURLToRaftAddress(p2pAddr)); err != nil {
+		return err
+	}
+
+	members := []CosignerConfig{}
+	for _, member := range s.members() {
+		if member.ID != shareID {
+			members = append(members, member)
+		}
+	}
+	members = append(members, CosignerConfig{ID: shareID, Address: p2pAddr})
+
+	return s.Emit(raftEventPeers, members)
+}
+
+// RemovePeer removes the cosigner with the given share ID from the raft voters and
+// replicates the new list of cosigners to the cluster. Must be called on the leader.
+func (s *RaftStore) RemovePeer(shareID int) error {
+	if s.raft.State() != raft.Leader || s.isWitness() {
+		return fmt.Errorf("not leader")
+	}
+	if fmt.Sprint(shareID) == s.NodeID {
+		return fmt.Errorf("cannot remove the raft leader, elect another leader first")
+	}
+
+	found := false
+	members := []CosignerConfig{}
+	for _, member := range s.members() {
+		if member.ID == shareID {
+			found = true
+			continue
+		}
+		members = append(members, member)
+	}
+	if !found {
+		return fmt.Errorf("cosigner %d is not a member of the cluster", shareID)
+	}
+	if len(members) < int(s.cosigner.threshold) {
+		return fmt.Errorf("cannot remove cosigner %d, %d cosigners would be left for threshold %d",
+			shareID, len(members), s.cosigner.threshold)
+	}
+
+	// Emit before removing the server so that the removed cosigner also learns about the change
+	if err := s.Emit(raftEventPeers, members); err != nil {
+		return err
+	}
+
+	return s.raft.RemoveServer(raft.ServerID(fmt.Sprint(shareID)), 0, 0).Error()
+}
+
 type fsm RaftStore
 
 // Apply applies a Raft log entry to the key-value store.
@@ -391,3 +714,98 @@ func (f *fsmSnapshot) Persist(sink raft.SnapshotSink) error {
 }
 
 func (f *fsmSnapshot) Release() {}
+
+// SetClusterPause replicates a pause of signing on all cosigners, or the resume if cp is not paused.
+// Must be called on the leader.
+func (s *RaftStore) SetClusterPause(cp ClusterPause) error {
+	if s.raft.State() != raft.Leader || s.isWitness() {
+		return fmt.Errorf("not leader")
+	}
+	if cp.Paused && cp.ResumeHeight != 0 && s.thresholdValidator != nil {
+		if height := s.thresholdValidator.lastSignedHRS().Height; cp.ResumeHeight <= height {
+			return fmt.Errorf("resume height %d must be above the last signed height %d", cp.ResumeHeight, height)
+		}
+	}
+	if !cp.Paused {
+		cp = ClusterPause{}
+	} else if cp.Since.IsZero() {
+		cp.Since = time.Now()
+	}
+	return s.Emit(raftEventPause, cp)
+}
+
+// applyClusterPause applies the replicated cluster pause and persists it so that a restart stays paused
+func (s *RaftStore) applyClusterPause(cp ClusterPause) {
+	if s.Pause == nil {
+		return
+	}
+	s.Pause.SetClusterPause(cp)
+	if s.PauseFile == "" {
+		return
+	}
+	if err := cp.save(s.PauseFile); err != nil {
+		s.logger.Error("Failed to persist cluster pause", "file", s.PauseFile, "error", err)
+	}
+}
+
+// SetClusterSignState replicates a sign state that every cosigner sets at the same raft log entry.
+// Signing must be paused on all cosigners so that no signing round is in progress.
+func (s *RaftStore) SetClusterSignState(ctx context.Context, ssc SignStateConsensus) error {
+	if s.raft.State() != raft.Leader || s.isWitness() {
+		return fmt.Errorf("not leader")
+	}
+	if !s.Pause.ClusterPause().Paused {
+		return fmt.Errorf("signing must be paused on all cosigners to set the sign state of the cluster")
+	}
+	if s.thresholdValidator != nil {
+		if err := s.thresholdValidator.waitForSignBlocks(ctx); err != nil {
+			return err
+		}
+		if err := s.thresholdValidator.lastSignState.GetErrorIfLessOrEqual(
+			ssc.Height, ssc.Round, ssc.Step, &s.thresholdValidator.lastSignStateMutex); err != nil {
+			return err
+		}
+	}
+	return s.Emit(raftEventSignState, lssEvent{SignStateConsensus: ssc, ChainID: s.Upgrade.ChainID()})
+}
+
+// applySignState sets the replicated sign state, a replayed sign state that is not ahead is ignored
+func (s *RaftStore) applySignState(ssc SignStateConsensus) {
+	var err error
+	if s.isWitness() {
+		err = setSignState(s.witnessSignState, &s.witnessSignStateMutex, ssc)
+	} else {
+		err = setSignStates(s.thresholdValidator, s.cosigner, ssc)
+	}
+	if err != nil {
+		s.logger.Debug("Sign state of the cluster not set", "height", ssc.Height, "error", err)
+		return
+	}
+	s.logger.Info("Sign state of the cluster set", "height", ssc.Height, "round", ssc.Round, "step", ssc.Step)
+}
+
+// applyChainUpgrade switches this node to the new chain of the plan, at the same raft log entry on every node
+func (s *RaftStore) applyChainUpgrade(plan UpgradePlan) {
+	var err error
+	if s.isWitness() {
+		if !s.Upgrade.switchTo(plan) {
+			return
+		}
+		err = s.witnessSignState.switchChain(s.Upgrade.PrivValStateFile(plan.NewChainID), plan.StartHeight,
+			&s.witnessSignStateMutex)
+		if err == nil && s.Upgrade.OnUpgraded != nil {
+			s.Upgrade.OnUpgraded(plan)
+		}
+	} else {
+		if s.Upgrade.ChainID() != plan.ChainID {
+			return
+		}
+		err = s.Upgrade.apply(plan, s.thresholdValidator, s.cosigner)
+	}
+	if err != nil {
+		s.logger.Error("Failed to switch chain", "new_chain_id", plan.NewChainID, "error", err)
+		return
+	}
+	s.logger.Info("Switched chain", "chain_id", plan.ChainID, "halt_height", plan.HaltHeight,
+		"new_chain_id", plan.NewChainID, "start_height", plan.StartHeight)
+}
@@ -3,12 +3,16 @@ package signer
 import (
 	"crypto/rand"
 	"crypto/rsa"
+	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/raft"
 	"github.com/stretchr/testify/require"
 	tmCryptoEd25519 "github.com/tendermint/tendermint/crypto/ed25519"
+	tmlog "github.com/tendermint/tendermint/libs/log"
 )
 
 // Test_StoreInMemOpenSingleNode tests that a command can be applied to the log
@@ -92,3 +96,194 @@ func Test_StoreInMemOpenSingleNode(t *testing.T) {
 		t.Fatalf("key has wrong value: %s", value)
 	}
 }
+
+// Test_WitnessReplicatesLSS tests that a witness saves replicated last sign state
+// entries to its sign state without a cosigner or threshold validator.
+func Test_WitnessReplicatesLSS(t *testing.T) {
+	stateFile := testSignStateFile(t, "state.json")
+
+	signState, err := LoadOrCreateSignState(stateFile)
+	require.NoError(t, err)
+
+	s := NewWitnessRaftStore("4", t.TempDir(), "127.0.0.1:0", 1*time.Second,
+		tmlog.NewNopLogger(), &signState, []Cosigner{})
+	require.True(t, s.isWitness())
+
+	lss, err := json.Marshal(NewSignStateConsensus(10, 2, stepPrecommit))
+	require.NoError(t, err)
+	(*fsm)(s).handleLSSEvent(string(lss))
+
+	require.Equal(t, HRSTKey{Height: 10, Round: 2, Step: stepPrecommit}, s.witnessLastSignedHRS())
+	require.Equal(t, int64(10), signState.Height)
+}
+
+func TestSignStateEvent(t *testing.T) {
+	privateKey := tmCryptoEd25519.GenPrivKey()
+	dir := t.TempDir()
+
+	signState, err := LoadOrCreateSignState(filepath.Join(dir, "state.json"))
+	require.NoError(t, err)
+	shareSignState, err := LoadOrCreateSignState(filepath.Join(dir, "share_state.json"))
+	require.NoError(t, err)
+
+	cosigner := NewLocalCosigner(LocalCosignerConfig{
+		CosignerKey: CosignerKey{PubKey: privateKey.PubKey(), ID: 1},
+		SignState:   &shareSignState,
+		Total:       2,
+		Threshold:   2,
+	})
+	logger := tmlog.NewNopLogger()
+	validator := NewThresholdValidator(&ThresholdValidatorOpt{
+		Pubkey:    privateKey.PubKey(),
+		Threshold: 2,
+		SignState: signState,
+		Cosigner:  cosigner,
+		Logger:    logger,
+	})
+	f := &fsm{
+		logger:             logger,
+		cosigner:           cosigner,
+		thresholdValidator: validator,
+		Upgrade:            NewChainUpgrade("horcrux-1", nil),
+	}
+
+	f.handleSignStateEvent(`{"Height":100,"Round":1,"Step":2,"ChainID":"horcrux-1"}`)
+	require.Equal(t, HRSTKey{Height: 100, Round: 1, Step: 2}, validator.lastSignedHRS())
+	require.Equal(t, HRSTKey{Height: 100, Round: 1, Step: 2}, cosigner.lastSignedHRS())
+
+	// saved synchronously
+	for _, file := range []string{filepath.Join(dir, "state.json"), filepath.Join(dir, "share_state.json")} {
+		saved, err := LoadSignState(file)
+		require.NoError(t, err)
+		require.Equal(t, int64(100), saved.Height)
+	}
+
+	// replayed by raft after the sign state moved on, or from before a chain upgrade
+	f.handleSignStateEvent(`{"Height":50,"Round":0,"Step":0,"ChainID":"horcrux-1"}`)
+	f.handleSignStateEvent(`{"Height":200,"Round":0,"Step":0,"ChainID":"horcrux-0"}`)
+	require.Equal(t, HRSTKey{Height: 100, Round: 1, Step: 2}, validator.lastSignedHRS())
+	require.Equal(t, HRSTKey{Height: 100, Round: 1, Step: 2}, cosigner.lastSignedHRS())
+}
+
+// newTestRaftStore returns the raft store of cosigner 1 of 3 with an in-memory raft.
+// If bootstrapped, it is the leader of a single node raft cluster, otherwise it never becomes the leader.
+func newTestRaftStore(t *testing.T, bootstrap bool) *RaftStore {
+	cosigner := NewLocalCosigner(LocalCosignerConfig{
+		CosignerKey: CosignerKey{PubKey: tmCryptoEd25519.PubKey{}, ID: 1, CosignerKeys: []*rsa.PublicKey{{}, {}, {}}},
+		SignState:   &SignState{},
+		Total:       3,
+		Threshold:   2,
+	})
+	s := &RaftStore{
+		NodeID:      "1",
+		RaftBind:    "tcp://127.0.0.1:2222",
+		RaftTimeout: 1 * time.Second,
+		m:           make(map[string]string),
+		logger:      tmlog.NewNopLogger(),
+		cosigner:    cosigner,
+		Peers: []Cosigner{
+			NewRemoteCosigner(2, "tcp://127.0.0.1:2223"),
+			NewRemoteCosigner(3, "tcp://127.0.0.1:2224"),
+		},
+		Pause: &SigningPause{},
+	}
+
+	config := raft.DefaultConfig()
+	config.LocalID = raft.ServerID(s.NodeID)
+	config.LogLevel = "ERROR"
+	config.HeartbeatTimeout = 50 * time.Millisecond
+	config.ElectionTimeout = 50 * time.Millisecond
+	config.LeaderLeaseTimeout = 50 * time.Millisecond
+	config.CommitTimeout = 5 * time.Millisecond
+	addr, transport := raft.NewInmemTransport("")
+	ra, err := raft.NewRaft(config, (*fsm)(s), raft.NewInmemStore(), raft.NewInmemStore(),
+		raft.NewInmemSnapshotStore(), transport)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ra.Shutdown().Error() })
+	s.raft = ra
+
+	if bootstrap {
+		configuration := raft.Configuration{Servers: []raft.Server{{ID: config.LocalID, Address: addr}}}
+		require.NoError(t, ra.BootstrapCluster(configuration).Error())
+		require.Eventually(t, func() bool { return ra.State() == raft.Leader }, 5*time.Second, 10*time.Millisecond)
+	}
+	return s
+}
+
+func TestAddPeerErrors(t *testing.T) {
+	follower := newTestRaftStore(t, false)
+	require.EqualError(t, follower.AddPeer(2, "tcp://127.0.0.1:2223"), "not leader")
+
+	s := newTestRaftStore(t, true)
+	require.EqualError(t, s.AddPeer(1, "tcp://127.0.0.1:2222"), "cosigner 1 is the raft leader")
+	require.EqualError(t, s.AddPeer(4, "tcp://127.0.0.1:2225"), "share ID 4 is out of range, must be between 1 and 3")
+}
+
+func TestRemovePeer(t *testing.T) {
+	follower := newTestRaftStore(t, false)
+	require.EqualError(t, follower.RemovePeer(2), "not leader")
+
+	s := newTestRaftStore(t, true)
+	var changed []CosignerConfig
+	s.OnPeersChanged = func(members []CosignerConfig) { changed = members }
+
+	require.EqualError(t, s.RemovePeer(1), "cannot remove the raft leader, elect another leader first")
+	require.EqualError(t, s.RemovePeer(4), "cosigner 4 is not a member of the cluster")
+
+	require.NoError(t, s.RemovePeer(3))
+	require.Equal(t, []CosignerConfig{
+		{ID: 1, Address: "tcp://127.0.0.1:2222"},
+		{ID: 2, Address: "tcp://127.0.0.1:2223"},
+	}, changed)
+	require.Len(t, s.getPeers(), 1)
+	require.Equal(t, 2, s.getPeers()[0].GetID())
+	_, ok := s.cosigner.getPeer(3)
+	require.False(t, ok)
+
+	// the threshold of 2 cosigners must remain
+	require.EqualError(t, s.RemovePeer(2), "cannot remove cosigner 2, 1 cosigners would be left for threshold 2")
+	require.Len(t, s.getPeers(), 1)
+}
+
+func TestPeersEventRemovesSelf(t *testing.T) {
+	const (
+		removed = `[{"ID":2,"Address":"tcp://127.0.0.1:2223"},{"ID":3,"Address":"tcp://127.0.0.1:2224"}]`
+		added   = `[{"ID":1,"Address":"tcp://127.0.0.1:2222"},{"ID":2,"Address":"tcp://127.0.0.1:2223"},` +
+			`{"ID":3,"Address":"tcp://127.0.0.1:2224"}]`
+	)
+
+	s := newTestRaftStore(t, false)
+	changed := false
+	s.OnPeersChanged = func([]CosignerConfig) { changed = true }
+
+	(*fsm)(s).handlePeersEvent(removed)
+
+	// the removed cosigner stops signing and keeps its configuration
+	paused, reason, _ := s.Pause.Paused()
+	require.True(t, paused)
+	require.Equal(t, "removed from the cluster", reason)
+	require.False(t, changed)
+	require.Len(t, s.getPeers(), 2)
+
+	// added back
+	(*fsm)(s).handlePeersEvent(added)
+	paused, _, _ = s.Pause.Paused()
+	require.False(t, paused)
+	require.True(t, changed)
+
+	// raft replays both events on restart, the last one decides
+	s = newTestRaftStore(t, false)
+	for _, event := range []string{removed, added, removed, added} {
+		(*fsm)(s).handlePeersEvent(event)
+	}
+	paused, _, _ = s.Pause.Paused()
+	require.False(t, paused)
+
+	// a pause of the operator is kept when added back
+	s.Pause.Pause("maintenance")
+	(*fsm)(s).handlePeersEvent(removed)
+	(*fsm)(s).handlePeersEvent(added)
+	paused, reason, _ = s.Pause.Paused()
+	require.True(t, paused)
+	require.Equal(t, "maintenance", reason)
+}
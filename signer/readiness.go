@@ -0,0 +1,112 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/raft"
+	proto "github.com/strangelove-ventures/horcrux/signer/proto"
+	tmLog "github.com/tendermint/tendermint/libs/log"
+)
+
+// DefaultReadinessTimeout is the default time to wait for a cosigner to be ready before connecting to the sentries
+const DefaultReadinessTimeout = 60 * time.Second
+
+// how often readiness is checked while waiting
+const readinessInterval = 500 * time.Millisecond
+
+// Readiness is implemented by services that must be ready to sign before connecting to the sentries
+type Readiness interface {
+	// Ready returns nil if ready to sign, or the reason it is not ready yet
+	Ready() error
+}
+
+// WaitUntilReady blocks until the service is ready to sign or the timeout expires.
+// Returns an error with the last reason the service was not ready if the timeout expired.
+func WaitUntilReady(logger tmLog.Logger, readiness Readiness, timeout time.Duration) error {
+	start := time.Now()
+	ticker := time.NewTicker(readinessInterval)
+	defer ticker.Stop()
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		err := readiness.Ready()
+		if err == nil {
+			startupGatingSeconds.Set(time.Since(start).Seconds())
+			logger.Info("Ready to sign, connecting to sentries", "waited", time.Since(start).Round(time.Millisecond))
+			return nil
+		}
+		logger.Debug("Not ready to sign yet", "reason", err)
+
+		select {
+		case <-timer.C:
+			startupGatingSeconds.Set(time.Since(start).Seconds())
+			totalStartupGatingTimeouts.Inc()
+			return fmt.Errorf("not ready to sign after %s: %w", timeout, err)
+		case <-ticker.C:
+		}
+	}
+}
+
+// checkQuorum returns an error if fewer than threshold cosigners, including ourselves, answer a ping
+func checkQuorum(threshold int, peers []Cosigner) error {
+	reachable := 1
+	for _, peer := range pingPeers(peers) {
+		if peer.Error == "" {
+			reachable++
+		}
+	}
+	if reachable < threshold {
+		return fmt.Errorf("only %d cosigners are reachable, %d are needed to sign", reachable, threshold)
+	}
+	return nil
+}
+
+// Ready returns nil once raft has a leader, enough cosigners to sign are reachable,
+// and the last sign state has caught up with the one of the leader.
+// Implements Readiness interface
+func (s *RaftStore) Ready() error {
+	if s.raft == nil {
+		return errors.New("raft not yet initialized")
+	}
+	if s.raft.Leader() == "" {
+		return errors.New("no raft leader")
+	}
+	if s.thresholdValidator == nil {
+		return errors.New("threshold validator not yet initialized")
+	}
+	if err := checkQuorum(s.thresholdValidator.threshold, s.getPeers()); err != nil {
+		return err
+	}
+	if s.raft.State() == raft.Leader {
+		return nil
+	}
+
+	client, conn, err := s.getLeaderGRPCClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	context, cancelFunc := getContext()
+	defer cancelFunc()
+	status, err := client.GetStatus(context, &proto.CosignerGRPCGetStatusRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to get leader status: %w", err)
+	}
+
+	leader := HRSTKeyFromProto(status.GetLastSignState())
+	ours := s.thresholdValidator.lastSignedHRS()
+	if ours.Less(leader) {
+		return fmt.Errorf("last sign state %d/%d/%d is behind the leader %d/%d/%d",
+			ours.Height, ours.Round, ours.Step, leader.Height, leader.Round, leader.Step)
+	}
+	return nil
+}
+
+// Ready returns nil once enough cosigners to sign are reachable.
+// Implements Readiness interface
+func (s *GRPCService) Ready() error {
+	return checkQuorum(s.thresholdValidator.threshold, s.thresholdValidator.getPeers())
+}
@@ -0,0 +1,41 @@
+package signer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+)
+
+type mockReadiness struct {
+	checks     int
+	readyAfter int
+}
+
+func (m *mockReadiness) Ready() error {
+	m.checks++
+	if m.checks < m.readyAfter {
+		return errors.New("no raft leader")
+	}
+	return nil
+}
+
+func TestWaitUntilReady(t *testing.T) {
+	readiness := &mockReadiness{readyAfter: 3}
+	require.NoError(t, WaitUntilReady(tmlog.NewNopLogger(), readiness, 5*time.Second))
+	require.Equal(t, 3, readiness.checks)
+
+	readiness = &mockReadiness{readyAfter: 100}
+	err := WaitUntilReady(tmlog.NewNopLogger(), readiness, readinessInterval)
+	require.EqualError(t, err, "not ready to sign after 500ms: no raft leader")
+}
+
+func TestCheckQuorum(t *testing.T) {
+	require.NoError(t, checkQuorum(1, []Cosigner{}))
+
+	// nothing is listening on the peer address
+	peers := []Cosigner{NewRemoteCosigner(2, "tcp://127.0.0.1:1")}
+	require.EqualError(t, checkQuorum(2, peers), "only 1 cosigners are reachable, 2 are needed to sign")
+}
@@ -106,3 +106,53 @@ func (cosigner *RemoteCosigner) SetEphemeralSecretPartsAndSign(
 		Signature:       res.GetSignature(),
 	}, nil
 }
+
+// Ping checks that the remote cosigner is reachable over gRPC and returns its clock reading
+func (cosigner *RemoteCosigner) Ping() (time.Time, error) {
+	client, conn, err := cosigner.getGRPCClient()
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer conn.Close()
+	context, cancelFunc := getContext()
+	defer cancelFunc()
+	res, err := client.Ping(context, &proto.CosignerGRPCPingRequest{})
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, res.GetTime()), nil
+}
+
+// GetStatus requests the status of the remote cosigner
+func (cosigner *RemoteCosigner) GetStatus() (*proto.CosignerGRPCGetStatusResponse, error) {
+	client, conn, err := cosigner.getGRPCClient()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	context, cancelFunc := getContext()
+	defer cancelFunc()
+	return client.GetStatus(context, &proto.CosignerGRPCGetStatusRequest{})
+}
+
+// GetLastSignState requests the last sign state of the validator from the remote cosigner
+func (cosigner *RemoteCosigner) GetLastSignState() (SignStateConsensus, error) {
+	client, conn, err := cosigner.getGRPCClient()
+	if err != nil {
+		return SignStateConsensus{}, err
+	}
+	defer conn.Close()
+	context, cancelFunc := getContext()
+	defer cancelFunc()
+	res, err := client.GetLastSignState(context, &proto.CosignerGRPCGetLastSignStateRequest{})
+	if err != nil {
+		return SignStateConsensus{}, err
+	}
+	return SignStateConsensus{
+		Height:    res.GetHrst().GetHeight(),
+		Round:     res.GetHrst().GetRound(),
+		Step:      int8(res.GetHrst().GetStep()),
+		SignBytes: res.GetSignBytes(),
+		Signature: res.GetSignature(),
+	}, nil
+}
@@ -1,8 +1,11 @@
 package signer
 
 import (
+	"context"
 	"fmt"
 	"net"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	tmCryptoEd2219 "github.com/tendermint/tendermint/crypto/ed25519"
@@ -28,6 +31,19 @@ type ReconnRemoteSigner struct {
 	privVal tm.PrivValidator
 
 	dialer net.Dialer
+
+	// holds a token while a request from the sentry is handled, a channel rather than a mutex
+	// so that Drain can stop waiting for it without leaving a goroutine behind
+	requestSem chan struct{}
+
+	// records sign requests from the sentry that conflict with already signed blocks, may be nil
+	slashingRisk *SlashingRiskDetector
+
+	// 1 while connected to the sentry
+	connected int32
+
+	// switches the chain ID at a chain upgrade, may be nil
+	upgrade *ChainUpgrade
 }
 
 // NewReconnRemoteSigner return a ReconnRemoteSigner that will dial using the given
@@ -48,12 +64,22 @@ func NewReconnRemoteSigner(
 		privVal: privVal,
 		dialer:  dialer,
 		privKey: tmCryptoEd2219.GenPrivKey(),
+
+		requestSem: make(chan struct{}, 1),
 	}
 
 	rs.BaseService = *tmService.NewBaseService(logger, "RemoteSigner", rs)
 	return rs
 }
 
+// getChainID returns the chain ID to sign for, which changes at a chain upgrade
+func (rs *ReconnRemoteSigner) getChainID() string {
+	if chainID := rs.upgrade.ChainID(); chainID != "" {
+		return chainID
+	}
+	return rs.chainID
+}
+
 // OnStart implements cmn.Service.
 func (rs *ReconnRemoteSigner) OnStart() error {
 	go rs.loop()
@@ -69,6 +95,7 @@ func (rs *ReconnRemoteSigner) loop() {
 				if err := conn.Close(); err != nil {
 					rs.Logger.Error("Close", "err", err.Error()+"closing listener failed")
 				}
+				rs.setConnected(false)
 			}
 			return
 		}
@@ -95,6 +122,7 @@ func (rs *ReconnRemoteSigner) loop() {
 				time.Sleep(time.Second * 3)
 				continue
 			}
+			rs.setConnected(true)
 		}
 
 		// since dialing can take time, we check running again
@@ -102,6 +130,7 @@ func (rs *ReconnRemoteSigner) loop() {
 			if err := conn.Close(); err != nil {
 				rs.Logger.Error("Close", "err", err.Error()+"closing listener failed")
 			}
+			rs.setConnected(false)
 			return
 		}
 
@@ -110,6 +139,15 @@ func (rs *ReconnRemoteSigner) loop() {
 			rs.Logger.Error("readMsg", "err", err)
 			conn.Close()
 			conn = nil
+			rs.setConnected(false)
+			rs.alertDisconnected(err)
+			continue
+		}
+
+		rs.requestSem <- struct{}{}
+		// do not accept new requests once stopped, the sentry will retry on another signer
+		if !rs.IsRunning() {
+			<-rs.requestSem
 			continue
 		}
 
@@ -117,14 +155,74 @@ func (rs *ReconnRemoteSigner) loop() {
 		res := rs.handleRequest(req)
 
 		err = WriteMsg(conn, res)
+		<-rs.requestSem
 		if err != nil {
 			rs.Logger.Error("writeMsg", "err", err)
 			conn.Close()
 			conn = nil
+			rs.setConnected(false)
+			rs.alertDisconnected(err)
 		}
 	}
 }
 
+// alertDisconnected fires an alert for the lost connection to the sentry, unless we are stopping
+func (rs *ReconnRemoteSigner) alertDisconnected(err error) {
+	if !rs.IsRunning() {
+		return
+	}
+	fireAlert(Alert{
+		Event:    AlertSentryDisconnected,
+		Severity: AlertWarning,
+		Summary:  fmt.Sprintf("Lost connection to sentry %s", rs.address),
+		Key:      rs.address,
+		Details: map[string]string{
+			"sentry": rs.address,
+			"error":  err.Error(),
+		},
+	})
+}
+
+// Drain waits for the request from the sentry that is being handled, if any.
+// Implements Drainer interface
+func (rs *ReconnRemoteSigner) Drain(ctx context.Context) error {
+	select {
+	case rs.requestSem <- struct{}{}:
+		<-rs.requestSem
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// connectedSentries is the number of sentries that all remote signers of this process are connected to
+var connectedSentries int32
+
+func addConnectedSentries(delta int32) {
+	sentriesConnected.Set(float64(atomic.AddInt32(&connectedSentries, delta)))
+}
+
+// setConnected records whether the remote signer is connected to its sentry
+func (rs *ReconnRemoteSigner) setConnected(connected bool) {
+	if connected {
+		atomic.StoreInt32(&rs.connected, 1)
+		addConnectedSentries(1)
+		return
+	}
+	atomic.StoreInt32(&rs.connected, 0)
+	addConnectedSentries(-1)
+}
+
+// IsConnected returns true while the remote signer is connected to its sentry
+func (rs *ReconnRemoteSigner) IsConnected() bool {
+	return atomic.LoadInt32(&rs.connected) == 1
+}
+
+// getConnectedSentries returns the number of sentries this process is connected to
+func getConnectedSentries() int {
+	return int(atomic.LoadInt32(&connectedSentries))
+}
+
 func (rs *ReconnRemoteSigner) handleRequest(req tmProtoPrivval.Message) tmProtoPrivval.Message {
 	switch typedReq := req.Sum.(type) {
 	case *tmProtoPrivval.Message_SignVoteRequest:
@@ -146,11 +244,18 @@ func (rs *ReconnRemoteSigner) handleSignVoteRequest(vote *tmProto.Vote) tmProtoP
 		Vote:  tmProto.Vote{},
 		Error: nil,
 	}}
-	if err := rs.privVal.SignVote(rs.chainID, vote); err != nil {
+	if err := rs.signVote(vote); err != nil {
 		switch typedErr := err.(type) {
 		case *BeyondBlockError:
 			rs.Logger.Debug("Rejecting sign vote request", "reason", typedErr.msg)
 			beyondBlockErrors.Inc()
+		case *PausedError:
+			rs.Logger.Debug("Rejecting sign vote request", "reason", typedErr.msg)
+		case *HaltHeightError:
+			rs.Logger.Info("Rejecting sign vote request", "reason", typedErr.msg)
+		case *ConflictingDataError:
+			rs.recordConflict(typedErr)
+			failedSignVote.Inc()
 		default:
 			rs.Logger.Error("Failed to sign vote", "address", rs.address, "error", err, "vote_type", vote.Type,
 				"height", vote.Height, "round", vote.Round, "validator", fmt.Sprintf("%X", vote.ValidatorAddress))
@@ -160,12 +265,16 @@ func (rs *ReconnRemoteSigner) handleSignVoteRequest(vote *tmProto.Vote) tmProtoP
 		return tmProtoPrivval.Message{Sum: msgSum}
 	}
 	rs.Logger.Info("Signed vote", "node", rs.address, "height", vote.Height, "round", vote.Round, "type", vote.Type)
+	signBytes := tm.VoteSignBytes(rs.getChainID(), vote)
+	rs.Logger.Debug("Signed vote sign bytes", "node", rs.address, "vote", ReadableSignBytes(signBytes))
+	rs.recordSigned(signBytes)
 
 	if vote.Type == tmProto.PrecommitType {
 		stepSize := vote.Height - previousPrecommitHeight
 		if previousPrecommitHeight != 0 && stepSize > 1 {
 			missedPrecommits.Add(float64(stepSize))
 			totalMissedPrecommits.Add(float64(stepSize))
+			alertMissedVotes(AlertMissedPrecommits, stepSize-1, vote.Height)
 		} else {
 			missedPrecommits.Set(0)
 		}
@@ -183,6 +292,7 @@ func (rs *ReconnRemoteSigner) handleSignVoteRequest(vote *tmProto.Vote) tmProtoP
 		if previousPrevoteHeight != 0 && stepSize > 1 {
 			missedPrevotes.Add(float64(stepSize))
 			totalMissedPrevotes.Add(float64(stepSize))
+			alertMissedVotes(AlertMissedPrevotes, stepSize-1, vote.Height)
 		} else {
 			missedPrevotes.Set(0)
 		}
@@ -206,11 +316,17 @@ func (rs *ReconnRemoteSigner) handleSignProposalRequest(proposal *tmProto.Propos
 			Proposal: tmProto.Proposal{},
 			Error:    nil,
 		}}
-	if err := rs.privVal.SignProposal(rs.chainID, proposal); err != nil {
+	if err := rs.signProposal(proposal); err != nil {
 		switch typedErr := err.(type) {
 		case *BeyondBlockError:
 			rs.Logger.Debug("Rejecting proposal sign request", "reason", typedErr.msg)
 			beyondBlockErrors.Inc()
+		case *PausedError:
+			rs.Logger.Debug("Rejecting proposal sign request", "reason", typedErr.msg)
+		case *HaltHeightError:
+			rs.Logger.Info("Rejecting proposal sign request", "reason", typedErr.msg)
+		case *ConflictingDataError:
+			rs.recordConflict(typedErr)
 		default:
 			rs.Logger.Error("Failed to sign proposal", "address", rs.address, "error", err, "proposal", proposal)
 		}
@@ -219,6 +335,9 @@ func (rs *ReconnRemoteSigner) handleSignProposalRequest(proposal *tmProto.Propos
 	}
 	rs.Logger.Info("Signed proposal", "node", rs.address,
 		"height", proposal.Height, "round", proposal.Round, "type", proposal.Type)
+	signBytes := tm.ProposalSignBytes(rs.getChainID(), proposal)
+	rs.Logger.Debug("Signed proposal sign bytes", "node", rs.address, "proposal", ReadableSignBytes(signBytes))
+	rs.recordSigned(signBytes)
 	lastProposalHeight.Set(float64(proposal.Height))
 	lastProposalRound.Set(float64(proposal.Round))
 	totalProposalsSigned.Inc()
@@ -226,6 +345,42 @@ func (rs *ReconnRemoteSigner) handleSignProposalRequest(proposal *tmProto.Propos
 	return tmProtoPrivval.Message{Sum: msgSum}
 }
 
+// sourceSigner is implemented by private validators that record the sentry that requested a signature
+type sourceSigner interface {
+	SignVoteFrom(source, chainID string, vote *tmProto.Vote) error
+	SignProposalFrom(source, chainID string, proposal *tmProto.Proposal) error
+}
+
+func (rs *ReconnRemoteSigner) signVote(vote *tmProto.Vote) error {
+	if privVal, ok := rs.privVal.(sourceSigner); ok {
+		return privVal.SignVoteFrom(rs.address, rs.getChainID(), vote)
+	}
+	return rs.privVal.SignVote(rs.getChainID(), vote)
+}
+
+func (rs *ReconnRemoteSigner) signProposal(proposal *tmProto.Proposal) error {
+	if privVal, ok := rs.privVal.(sourceSigner); ok {
+		return privVal.SignProposalFrom(rs.address, rs.getChainID(), proposal)
+	}
+	return rs.privVal.SignProposal(rs.getChainID(), proposal)
+}
+
+// recordSigned remembers that the sentry requested the signed sign bytes
+func (rs *ReconnRemoteSigner) recordSigned(signBytes []byte) {
+	if rs.slashingRisk != nil {
+		rs.slashingRisk.RecordSigned(rs.address, signBytes)
+	}
+}
+
+// recordConflict records a sign request from the sentry that conflicts with an already signed block
+func (rs *ReconnRemoteSigner) recordConflict(conflict *ConflictingDataError) {
+	if rs.slashingRisk != nil {
+		rs.slashingRisk.RecordConflict(rs.address, conflict)
+		return
+	}
+	rs.Logger.Error("Refused conflicting sign request", "address", rs.address, "error", conflict)
+}
+
 func (rs *ReconnRemoteSigner) handlePubKeyRequest() tmProtoPrivval.Message {
 	totalPubKeyRequests.Inc()
 	msgSum := &tmProtoPrivval.Message_PubKeyResponse{PubKeyResponse: &tmProtoPrivval.PubKeyResponse{
@@ -263,22 +418,181 @@ func getRemoteSignerError(err error) *tmProtoPrivval.RemoteSignerError {
 }
 
 func StartRemoteSigners(services []tmService.Service, logger tmLog.Logger, chainID string,
-	privVal tm.PrivValidator, nodes []NodeConfig) ([]tmService.Service, error) {
-	var err error
+	privVal tm.PrivValidator, nodes []NodeConfig, slashingRisk *SlashingRiskDetector) ([]tmService.Service, error) {
+	remoteSigners := NewRemoteSigners(logger, chainID, privVal, nodes, slashingRisk)
+	if err := remoteSigners.Start(); err != nil {
+		return nil, err
+	}
+	return append(services, remoteSigners), nil
+}
+
+// RemoteSigners runs a ReconnRemoteSigner for each of the sentries of the validator.
+// The sentries may be changed at runtime with SetNodes, i.e. when the config is reloaded.
+type RemoteSigners struct {
+	tmService.BaseService
+
+	logger       tmLog.Logger
+	chainID      string
+	privVal      tm.PrivValidator
+	slashingRisk *SlashingRiskDetector
+
+	// Upgrade switches the chain ID of the remote signers at a chain upgrade, may be nil
+	Upgrade *ChainUpgrade
+
+	mu      sync.Mutex
+	nodes   []NodeConfig
+	signers map[string]*ReconnRemoteSigner
+}
+
+// SentryStatus is the connection status of the remote signer of a sentry
+type SentryStatus struct {
+	Address   string
+	Connected bool
+}
+
+// NewRemoteSigners returns a RemoteSigners for the sentries, slashingRisk may be nil
+func NewRemoteSigners(logger tmLog.Logger, chainID string, privVal tm.PrivValidator,
+	nodes []NodeConfig, slashingRisk *SlashingRiskDetector) *RemoteSigners {
+	rs := &RemoteSigners{
+		logger:       logger,
+		chainID:      chainID,
+		privVal:      privVal,
+		slashingRisk: slashingRisk,
+		nodes:        nodes,
+		signers:      make(map[string]*ReconnRemoteSigner),
+	}
+	rs.BaseService = *tmService.NewBaseService(logger, "RemoteSigners", rs)
+	return rs
+}
+
+// OnStart connects to all sentries
+func (rs *RemoteSigners) OnStart() error {
 	go StartMetrics()
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	for _, node := range rs.nodes {
+		if err := rs.startSigner(node.Address); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// OnStop disconnects from all sentries
+func (rs *RemoteSigners) OnStop() {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	for _, s := range rs.signers {
+		if s.IsRunning() {
+			if err := s.Stop(); err != nil {
+				rs.logger.Error("Failed to stop remote signer", "address", s.address, "error", err)
+			}
+		}
+	}
+}
+
+// startSigner starts a remote signer for the sentry at the address, rs.mu must be held
+func (rs *RemoteSigners) startSigner(address string) error {
+	// Tendermint requires a connection within 3 seconds of start or crashes
+	// A long timeout such as 30 seconds would cause the sentry to fail in loops
+	// Use a short timeout and dial often to connect within 3 second window
+	dialer := net.Dialer{Timeout: 2 * time.Second}
+	s := NewReconnRemoteSigner(address, rs.logger, rs.chainID, rs.privVal, dialer)
+	s.slashingRisk = rs.slashingRisk
+	s.upgrade = rs.Upgrade
+	if err := s.Start(); err != nil {
+		return err
+	}
+	rs.signers[address] = s
+	return nil
+}
+
+// SetNodes connects to the sentries that are new and disconnects from the ones that were removed.
+// Returns the addresses of the added and removed sentries, none if not started yet.
+func (rs *RemoteSigners) SetNodes(nodes []NodeConfig) (added, removed []string, err error) {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+
+	if !rs.IsRunning() {
+		// connected to on start
+		rs.nodes = nodes
+		return nil, nil, nil
+	}
+
+	keep := make(map[string]bool, len(nodes))
 	for _, node := range nodes {
-		// Tendermint requires a connection within 3 seconds of start or crashes
-		// A long timeout such as 30 seconds would cause the sentry to fail in loops
-		// Use a short timeout and dial often to connect within 3 second window
-		dialer := net.Dialer{Timeout: 2 * time.Second}
-		s := NewReconnRemoteSigner(node.Address, logger, chainID, privVal, dialer)
+		keep[node.Address] = true
+	}
+	for address, s := range rs.signers {
+		if keep[address] {
+			continue
+		}
+		// let the request in progress, if any, complete before disconnecting
+		ctx, cancel := context.WithTimeout(context.Background(), DefaultDrainTimeout)
+		if err := s.Stop(); err != nil {
+			cancel()
+			return added, removed, err
+		}
+		_ = s.Drain(ctx)
+		cancel()
+		delete(rs.signers, address)
+		removed = append(removed, address)
+		rs.logger.Info("Removed sentry", "address", address)
+	}
+	for _, node := range nodes {
+		if _, ok := rs.signers[node.Address]; ok {
+			continue
+		}
+		if err := rs.startSigner(node.Address); err != nil {
+			return added, removed, err
+		}
+		added = append(added, node.Address)
+		rs.logger.Info("Added sentry", "address", node.Address)
+	}
+	rs.nodes = nodes
+	return added, removed, nil
+}
 
-		err = s.Start()
-		if err != nil {
-			return nil, err
+// Status returns the connection status of each sentry, in the configured order
+func (rs *RemoteSigners) Status() []SentryStatus {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	status := make([]SentryStatus, 0, len(rs.nodes))
+	for _, node := range rs.nodes {
+		s, ok := rs.signers[node.Address]
+		status = append(status, SentryStatus{Address: node.Address, Connected: ok && s.IsConnected()})
+	}
+	return status
+}
+
+// stopSigners stops accepting new requests from all sentries
+func (rs *RemoteSigners) stopSigners() error {
+	rs.mu.Lock()
+	defer rs.mu.Unlock()
+	for _, s := range rs.signers {
+		if !s.IsRunning() {
+			continue
+		}
+		if err := s.Stop(); err != nil {
+			return err
 		}
+	}
+	return nil
+}
 
-		services = append(services, s)
+// Drain waits for the requests from the sentries that are being handled.
+// Implements Drainer interface
+func (rs *RemoteSigners) Drain(ctx context.Context) error {
+	rs.mu.Lock()
+	signers := make([]*ReconnRemoteSigner, 0, len(rs.signers))
+	for _, s := range rs.signers {
+		signers = append(signers, s)
 	}
-	return services, err
+	rs.mu.Unlock()
+	for _, s := range signers {
+		if err := s.Drain(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,28 @@
+package signer
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+)
+
+func TestReconnRemoteSignerDrain(t *testing.T) {
+	rs := NewReconnRemoteSigner("tcp://127.0.0.1:1", tmlog.NewNopLogger(), "chain-id", nil, net.Dialer{})
+
+	// a request from the sentry is being handled
+	rs.requestSem <- struct{}{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, rs.Drain(ctx), context.DeadlineExceeded)
+
+	// handled, the timed out drain left nothing waiting for the request
+	<-rs.requestSem
+	require.Len(t, rs.requestSem, 0)
+	require.NoError(t, rs.Drain(context.Background()))
+	require.Len(t, rs.requestSem, 0)
+}
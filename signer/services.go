@@ -1,6 +1,7 @@
 package signer
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"os"
@@ -8,6 +9,7 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	tmLog "github.com/tendermint/tendermint/libs/log"
 	tmOS "github.com/tendermint/tendermint/libs/os"
@@ -68,7 +70,21 @@ manual deletion of PID file required`, pidFilePath, pid)
 	return fmt.Errorf("unexpected error while signaling horcrux PID: %d", pid)
 }
 
-func WaitAndTerminate(logger tmLog.Logger, services []tmService.Service, pidFilePath string) {
+// DefaultDrainTimeout is the default time to wait for in-flight work on shutdown
+const DefaultDrainTimeout = 10 * time.Second
+
+// Drainer is implemented by services that finish in-flight work before the process exits
+type Drainer interface {
+	// Drain is called after the sentry connections have been stopped and before any other service is stopped.
+	Drain(ctx context.Context) error
+}
+
+func WaitAndTerminate(
+	logger tmLog.Logger,
+	services []tmService.Service,
+	pidFilePath string,
+	drainTimeout time.Duration,
+) {
 	wg := sync.WaitGroup{}
 	wg.Add(1)
 
@@ -85,7 +101,11 @@ func WaitAndTerminate(logger tmLog.Logger, services []tmService.Service, pidFile
 		if err := os.Remove(pidFilePath); err != nil {
 			fmt.Printf("Error removing lock file: %v\n", err)
 		}
+		drainServices(logger, services, drainTimeout)
 		for _, service := range services {
+			if !service.IsRunning() {
+				continue
+			}
 			err := service.Stop()
 			if err != nil {
 				panic(err)
@@ -95,3 +115,32 @@ func WaitAndTerminate(logger tmLog.Logger, services []tmService.Service, pidFile
 	})
 	wg.Wait()
 }
+
+// drainServices stops accepting new requests from the sentries, then lets every Drainer
+// finish its in-flight work, in the reverse order that the services were started.
+func drainServices(logger tmLog.Logger, services []tmService.Service, drainTimeout time.Duration) {
+	for _, service := range services {
+		switch rs := service.(type) {
+		case *ReconnRemoteSigner:
+			if err := rs.Stop(); err != nil {
+				panic(err)
+			}
+		case *RemoteSigners:
+			if err := rs.stopSigners(); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), drainTimeout)
+	defer cancel()
+	for i := len(services) - 1; i >= 0; i-- {
+		drainer, ok := services[i].(Drainer)
+		if !ok {
+			continue
+		}
+		if err := drainer.Drain(ctx); err != nil {
+			logger.Error("Failed to drain service", "service", services[i].String(), "error", err)
+		}
+	}
+}
@@ -131,7 +131,7 @@ func TestConcurrentStart(t *testing.T) {
 	for i := 0; i < concurrentAttempts; i++ {
 		go func() {
 			defer recoverFromPanic()
-			signer.WaitAndTerminate(logger, services, pidFilePath)
+			signer.WaitAndTerminate(logger, services, pidFilePath, signer.DefaultDrainTimeout)
 			doneCount++
 			wg.Done()
 		}()
@@ -150,7 +150,7 @@ func TestIsRunningAndWaitForService(t *testing.T) {
 
 	var logger tmlog.Logger
 	var services []tmservice.Service
-	go func() { signer.WaitAndTerminate(logger, services, pidFilePath) }()
+	go func() { signer.WaitAndTerminate(logger, services, pidFilePath, signer.DefaultDrainTimeout) }()
 
 	// Wait for signer.WaitAndTerminate to create pidFile
 	var err error
@@ -10,9 +10,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 	tmBytes "github.com/tendermint/tendermint/libs/bytes"
-	tmJson "github.com/tendermint/tendermint/libs/json"
 	"github.com/tendermint/tendermint/libs/protoio"
-	"github.com/tendermint/tendermint/libs/tempfile"
 	tmProto "github.com/tendermint/tendermint/proto/tendermint/types"
 )
 
@@ -23,6 +21,9 @@ const (
 	blocksToCache      = 3
 )
 
+// asyncSignStateSaves tracks the asynchronous saves of sign states in progress, Close waits for them
+var asyncSignStateSaves sync.WaitGroup
+
 func CanonicalVoteToStep(vote *tmProto.CanonicalVote) int8 {
 	switch vote.Type {
 	case tmProto.PrevoteType:
@@ -59,7 +60,7 @@ type SignState struct {
 	SignBytes       tmBytes.HexBytes `json:"signbytes,omitempty"`
 	cache           map[HRSKey]SignStateConsensus
 
-	filePath string
+	store SignStateStore
 }
 
 type SignStateConsensus struct {
@@ -78,16 +79,25 @@ func NewSignStateConsensus(height int64, round int64, step int8) SignStateConsen
 	}
 }
 
+// ConflictingDataError is returned when a block with the same HRS as an already signed block
+// differs by more than its timestamp. Signing it would be a double sign.
 type ConflictingDataError struct {
 	msg string
+
+	Reason            string
+	ExistingSignBytes []byte
+	NewSignBytes      []byte
 }
 
 func (e *ConflictingDataError) Error() string { return e.msg }
 
-func newConflictingDataError(existingSignBytes, newSignBytes []byte) *ConflictingDataError {
+func newConflictingDataError(reason string, existingSignBytes, newSignBytes []byte) *ConflictingDataError {
 	return &ConflictingDataError{
-		msg: fmt.Sprintf("conflicting data. existing: %s - new: %s",
+		msg: fmt.Sprintf("%s. existing: %s - new: %s", reason,
 			hex.EncodeToString(existingSignBytes), hex.EncodeToString(newSignBytes)),
+		Reason:            reason,
+		ExistingSignBytes: existingSignBytes,
+		NewSignBytes:      newSignBytes,
 	}
 }
 
@@ -134,7 +144,9 @@ func (signState *SignState) Save(ssc SignStateConsensus, lock *sync.Mutex, async
 	signState.Signature = ssc.Signature
 	signState.SignBytes = ssc.SignBytes
 	if async {
+		asyncSignStateSaves.Add(1)
 		go func() {
+			defer asyncSignStateSaves.Done()
 			signState.save()
 		}()
 	} else {
@@ -144,25 +156,64 @@ func (signState *SignState) Save(ssc SignStateConsensus, lock *sync.Mutex, async
 	return nil
 }
 
-// Save persists the FilePvLastSignState to its filePath.
-func (signState *SignState) save() {
-	outFile := signState.filePath
-	if outFile == "none" {
-		return
+// consensus returns a copy of the height, round, step, sign bytes and signature of the sign state
+func (signState *SignState) consensus(lock *sync.Mutex) SignStateConsensus {
+	if lock != nil {
+		lock.Lock()
+		defer lock.Unlock()
 	}
-	if outFile == "" {
-		panic("cannot save SignState: filePath not set")
+	return SignStateConsensus{
+		Height:    signState.Height,
+		Round:     signState.Round,
+		Step:      signState.Step,
+		Signature: signState.Signature,
+		SignBytes: signState.SignBytes,
 	}
-	jsonBytes, err := tmJson.MarshalIndent(signState, "", "  ")
-	if err != nil {
-		panic(err)
+}
+
+// Flush synchronously persists the sign state, e.g. before shutting down.
+func (signState *SignState) Flush(lock *sync.Mutex) {
+	if lock != nil {
+		lock.Lock()
+		defer lock.Unlock()
 	}
-	err = tempfile.WriteFileAtomic(outFile, jsonBytes, 0600)
-	if err != nil {
+	signState.save()
+}
+
+// MigrateLegacy rewrites a JSON sign state without a version, as written by earlier versions of horcrux,
+// with a version and checksum. Returns whether it was rewritten.
+// Loading a sign state never rewrites it, so that inspecting it leaves it as it is.
+func (signState *SignState) MigrateLegacy() (bool, error) {
+	store, ok := signState.store.(*jsonSignStateStore)
+	if !ok || !store.legacy {
+		return false, nil
+	}
+	if err := store.Save(signState); err != nil {
+		return false, fmt.Errorf("error rewriting sign state %s with a checksum: %w", store.filePath, err)
+	}
+	store.legacy = false
+	return true, nil
+}
+
+// save persists the sign state to its store.
+func (signState *SignState) save() {
+	if signState.store == nil {
+		panic("cannot save SignState: store not set")
+	}
+	if err := signState.store.Save(signState); err != nil {
 		panic(err)
 	}
 }
 
+// Close waits for the asynchronous saves in progress and releases the store of the sign state.
+func (signState *SignState) Close() error {
+	asyncSignStateSaves.Wait()
+	if signState.store == nil {
+		return nil
+	}
+	return signState.store.Close()
+}
+
 // CheckHRS checks the given height, round, step (HRS) against that of the
 // SignState. It returns an error if the arguments constitute a regression,
 // or if they match but the SignBytes are empty.
@@ -245,43 +296,45 @@ func (signState *SignState) GetErrorIfLessOrEqual(height int64, round int64, ste
 	return nil
 }
 
-// LoadSignState loads a sign state from disk.
+// LoadSignState loads a sign state from disk, from a BoltDB database
+// if the file has the BoltSignStateExt extension and from a JSON file otherwise.
 func LoadSignState(filepath string) (SignState, error) {
-	state := SignState{}
-	stateJSONBytes, err := os.ReadFile(filepath)
+	// opening the database would create it
+	if _, err := os.Stat(filepath); err != nil {
+		return SignState{}, err
+	}
+	store, err := openSignStateStore(filepath)
 	if err != nil {
-		return state, err
+		return SignState{}, err
 	}
-
-	err = tmJson.Unmarshal(stateJSONBytes, &state)
+	state, err := store.Load()
 	if err != nil {
+		_ = store.Close()
 		return state, err
 	}
-	state.cache = make(map[HRSKey]SignStateConsensus)
-	state.cache[HRSKey{Height: state.Height, Round: state.Round, Step: state.Step}] = SignStateConsensus{
-		Height:    state.Height,
-		Round:     state.Round,
-		Step:      state.Step,
-		Signature: state.Signature,
-		SignBytes: state.SignBytes,
-	}
-	state.filePath = filepath
+	state.store = store
 	return state, nil
 }
 
 // LoadOrCreateSignState loads the sign state from filepath
-// If the sign state could not be loaded, an empty sign state is initialized
-// and saved to filepath.
+// If the sign state does not exist, an empty sign state is initialized
+// and saved to filepath. A sign state that can not be loaded is never replaced.
 func LoadOrCreateSignState(filepath string) (SignState, error) {
 	existing, err := LoadSignState(filepath)
 	if err == nil {
 		return existing, nil
 	}
+	if !os.IsNotExist(err) {
+		return existing, err
+	}
 
-	// There was an error loading the sign state
 	// Make an empty sign state and save it
+	store, err := openSignStateStore(filepath)
+	if err != nil {
+		return SignState{}, err
+	}
 	state := SignState{}
-	state.filePath = filepath
+	state.store = store
 	state.cache = make(map[HRSKey]SignStateConsensus)
 	state.save()
 	return state, nil
@@ -325,16 +378,18 @@ func checkVoteOnlyDifferByTimestamp(lastSignBytes, newSignBytes []byte) error {
 		lastVoteBlockID := lastVote.GetBlockID()
 		newVoteBlockID := newVote.GetBlockID()
 		if newVoteBlockID == nil && lastVoteBlockID != nil {
-			return errors.New("already signed vote with non-nil BlockID. refusing to sign vote on nil BlockID")
+			return newConflictingDataError("already signed vote with non-nil BlockID, refusing to sign vote on nil BlockID",
+				lastSignBytes, newSignBytes)
 		}
 		if newVoteBlockID != nil && lastVoteBlockID == nil {
-			return errors.New("already signed vote with nil BlockID. refusing to sign vote on non-nil BlockID")
+			return newConflictingDataError("already signed vote with nil BlockID, refusing to sign vote on non-nil BlockID",
+				lastSignBytes, newSignBytes)
 		}
 		if !bytes.Equal(lastVoteBlockID.GetHash(), newVoteBlockID.GetHash()) {
-			return fmt.Errorf("differing block IDs - last Vote: %s, new Vote: %s",
-				lastVoteBlockID.GetHash(), newVoteBlockID.GetHash())
+			return newConflictingDataError(fmt.Sprintf("differing block IDs - last Vote: %X, new Vote: %X",
+				lastVoteBlockID.GetHash(), newVoteBlockID.GetHash()), lastSignBytes, newSignBytes)
 		}
-		return newConflictingDataError(lastSignBytes, newSignBytes)
+		return newConflictingDataError("conflicting data", lastSignBytes, newSignBytes)
 	}
 
 	return nil
@@ -355,7 +410,7 @@ func checkProposalOnlyDifferByTimestamp(lastSignBytes, newSignBytes []byte) erro
 	isEqual := proto.Equal(&newProposal, &lastProposal)
 
 	if !isEqual {
-		return newConflictingDataError(lastSignBytes, newSignBytes)
+		return newConflictingDataError("conflicting data", lastSignBytes, newSignBytes)
 	}
 
 	return nil
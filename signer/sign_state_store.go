@@ -0,0 +1,372 @@
+package signer
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	tmBytes "github.com/tendermint/tendermint/libs/bytes"
+	tmJson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/tempfile"
+	bolt "go.etcd.io/bbolt"
+)
+
+// BoltSignStateExt is the file extension of sign states stored in a BoltDB database,
+// sign states in any other file are stored as JSON.
+const BoltSignStateExt = ".db"
+
+// signStateVersion is the version of the format of persisted sign states.
+// JSON sign state files without a version were written by earlier versions of horcrux and have no checksum,
+// they are rewritten with a version and checksum when the signer starts.
+const signStateVersion = 1
+
+var (
+	boltSignStateBucket = []byte("sign_state")
+	boltHistoryBucket   = []byte("history")
+	boltWatermarkKey    = []byte("watermark")
+)
+
+// SignStateStore persists a sign state.
+type SignStateStore interface {
+	// Load reads the persisted sign state with the recently signed blocks that are kept by the store.
+	// The error satisfies os.IsNotExist if nothing has been persisted yet.
+	Load() (SignState, error)
+
+	// Save persists the watermark of the sign state.
+	Save(signState *SignState) error
+
+	// Close releases the store.
+	Close() error
+}
+
+// SignStateCorruptedError is returned for a persisted sign state that can not be read or fails its checksum.
+// Such a sign state is never recreated, an empty one would allow double signs.
+type SignStateCorruptedError struct {
+	msg string
+}
+
+func (e *SignStateCorruptedError) Error() string { return e.msg }
+
+func newSignStateCorruptedError(filePath string, err error) *SignStateCorruptedError {
+	return &SignStateCorruptedError{
+		msg: fmt.Sprintf("sign state %s is corrupted: %v. Restore it from a backup, or move it away and "+
+			"set the sign state above the last height signed by the validator with "+
+			"horcrux state import --from-node or horcrux state set", filePath, err),
+	}
+}
+
+// signStateRecord is the persisted format of a sign state
+type signStateRecord struct {
+	Version         int32            `json:"version"`
+	Height          int64            `json:"height"`
+	Round           int64            `json:"round"`
+	Step            int8             `json:"step"`
+	EphemeralPublic []byte           `json:"ephemeral_public"`
+	Signature       []byte           `json:"signature,omitempty"`
+	SignBytes       tmBytes.HexBytes `json:"signbytes,omitempty"`
+	// SHA-256 of the record without the checksum
+	Checksum tmBytes.HexBytes `json:"checksum,omitempty"`
+}
+
+func (r signStateRecord) checksum() (tmBytes.HexBytes, error) {
+	r.Checksum = nil
+	bz, err := tmJson.Marshal(r)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(bz)
+	return sum[:], nil
+}
+
+// marshalSignState encodes the sign state in the current version with its checksum
+func marshalSignState(signState *SignState, indent bool) ([]byte, error) {
+	record := signStateRecord{
+		Version:         signStateVersion,
+		Height:          signState.Height,
+		Round:           signState.Round,
+		Step:            signState.Step,
+		EphemeralPublic: signState.EphemeralPublic,
+		Signature:       signState.Signature,
+		SignBytes:       signState.SignBytes,
+	}
+	checksum, err := record.checksum()
+	if err != nil {
+		return nil, err
+	}
+	record.Checksum = checksum
+	if indent {
+		return tmJson.MarshalIndent(record, "", "  ")
+	}
+	return tmJson.Marshal(record)
+}
+
+// unmarshalSignState decodes a persisted sign state and verifies its checksum.
+// With allowLegacy, a sign state without a version is accepted without a checksum, but it must have
+// a height, round and step. Returns whether the sign state is such a legacy one.
+func unmarshalSignState(bz []byte, allowLegacy bool) (SignState, bool, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &fields); err != nil {
+		return SignState{}, false, err
+	}
+	for _, field := range []string{"height", "round", "step"} {
+		if _, ok := fields[field]; !ok {
+			return SignState{}, false, fmt.Errorf("%s is missing", field)
+		}
+	}
+	var record signStateRecord
+	if err := tmJson.Unmarshal(bz, &record); err != nil {
+		return SignState{}, false, err
+	}
+	_, hasVersion := fields["version"]
+	_, hasChecksum := fields["checksum"]
+	switch {
+	case hasVersion:
+		if record.Version < 1 || record.Version > signStateVersion {
+			return SignState{}, false,
+				fmt.Errorf("unsupported version %d, expected at most %d", record.Version, signStateVersion)
+		}
+		checksum, err := record.checksum()
+		if err != nil {
+			return SignState{}, false, err
+		}
+		if !bytes.Equal(checksum, record.Checksum) {
+			return SignState{}, false,
+				fmt.Errorf("checksum %s does not match the contents, expected %s", record.Checksum, checksum)
+		}
+	case hasChecksum:
+		// the version has been removed to skip the checksum
+		return SignState{}, false, errors.New("version is missing, but there is a checksum")
+	case !allowLegacy:
+		return SignState{}, false, errors.New("version is missing")
+	}
+	return SignState{
+		Height:          record.Height,
+		Round:           record.Round,
+		Step:            record.Step,
+		EphemeralPublic: record.EphemeralPublic,
+		Signature:       record.Signature,
+		SignBytes:       record.SignBytes,
+	}, !hasVersion, nil
+}
+
+// openSignStateStore opens the store of the sign state file,
+// a BoltDB database for the BoltSignStateExt extension and a JSON file otherwise.
+func openSignStateStore(filePath string) (SignStateStore, error) {
+	if filepath.Ext(filePath) == BoltSignStateExt {
+		return openBoltSignStateStore(filePath)
+	}
+	return &jsonSignStateStore{filePath: filePath}, nil
+}
+
+// noopSignStateStore does not persist the sign state, i.e. for the sign requests that were initiated
+type noopSignStateStore struct{}
+
+func (noopSignStateStore) Load() (SignState, error) {
+	return SignState{}, os.ErrNotExist
+}
+
+func (noopSignStateStore) Save(*SignState) error { return nil }
+
+func (noopSignStateStore) Close() error { return nil }
+
+// jsonSignStateStore rewrites the sign state to a JSON file on every save.
+// Only the watermark is persisted.
+type jsonSignStateStore struct {
+	filePath string
+
+	// the loaded sign state has no version, until it is migrated
+	legacy bool
+}
+
+func (s *jsonSignStateStore) Load() (SignState, error) {
+	stateJSONBytes, err := os.ReadFile(s.filePath)
+	if err != nil {
+		return SignState{}, err
+	}
+
+	state, legacy, err := unmarshalSignState(stateJSONBytes, true)
+	if err != nil {
+		return state, newSignStateCorruptedError(s.filePath, err)
+	}
+	state.cache = make(map[HRSKey]SignStateConsensus)
+	state.cache[HRSKey{Height: state.Height, Round: state.Round, Step: state.Step}] = state.consensus(nil)
+	s.legacy = legacy
+	return state, nil
+}
+
+func (s *jsonSignStateStore) Save(signState *SignState) error {
+	jsonBytes, err := marshalSignState(signState, true)
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(s.filePath, jsonBytes, 0600)
+}
+
+func (s *jsonSignStateStore) Close() error { return nil }
+
+// boltSignStateStore keeps the watermark and the history of the recently signed blocks in a BoltDB database.
+// Both are updated in one transaction.
+type boltSignStateStore struct {
+	db *bolt.DB
+}
+
+func openBoltSignStateStore(filePath string) (*boltSignStateStore, error) {
+	db, err := bolt.Open(filePath, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, fmt.Errorf("sign state database %s is in use by another process", filePath)
+		}
+		return nil, fmt.Errorf("error opening sign state database %s: %w", filePath, err)
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		if _, err := tx.CreateBucketIfNotExists(boltSignStateBucket); err != nil {
+			return err
+		}
+		_, err := tx.CreateBucketIfNotExists(boltHistoryBucket)
+		return err
+	})
+	if err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	return &boltSignStateStore{db: db}, nil
+}
+
+// boltHistoryKey orders the history by height, round and step
+func boltHistoryKey(hrs HRSKey) []byte {
+	key := make([]byte, 17)
+	binary.BigEndian.PutUint64(key[0:8], uint64(hrs.Height))
+	binary.BigEndian.PutUint64(key[8:16], uint64(hrs.Round))
+	key[16] = byte(hrs.Step)
+	return key
+}
+
+func (s *boltSignStateStore) Load() (SignState, error) {
+	var state SignState
+	err := s.db.View(func(tx *bolt.Tx) error {
+		watermark := tx.Bucket(boltSignStateBucket).Get(boltWatermarkKey)
+		if watermark == nil {
+			return &os.PathError{Op: "load", Path: s.db.Path(), Err: os.ErrNotExist}
+		}
+		var err error
+		state, _, err = unmarshalSignState(watermark, false)
+		if err != nil {
+			return newSignStateCorruptedError(s.db.Path(), err)
+		}
+		state.cache = make(map[HRSKey]SignStateConsensus)
+		err = tx.Bucket(boltHistoryBucket).ForEach(func(_, v []byte) error {
+			var ssc SignStateConsensus
+			if err := tmJson.Unmarshal(v, &ssc); err != nil {
+				return err
+			}
+			state.cache[HRSKey{Height: ssc.Height, Round: ssc.Round, Step: ssc.Step}] = ssc
+			return nil
+		})
+		if err != nil {
+			return newSignStateCorruptedError(s.db.Path(), fmt.Errorf("error reading history: %w", err))
+		}
+		state.cache[HRSKey{Height: state.Height, Round: state.Round, Step: state.Step}] = state.consensus(nil)
+		return nil
+	})
+	return state, err
+}
+
+func (s *boltSignStateStore) Save(signState *SignState) error {
+	watermark, err := marshalSignState(signState, false)
+	if err != nil {
+		return err
+	}
+	ssc := signState.consensus(nil)
+	entry, err := tmJson.Marshal(ssc)
+	if err != nil {
+		return err
+	}
+	hrs := HRSKey{Height: ssc.Height, Round: ssc.Round, Step: ssc.Step}
+
+	return s.db.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(boltSignStateBucket)
+		if existing := bucket.Get(boltWatermarkKey); existing != nil {
+			current, _, err := unmarshalSignState(existing, false)
+			if err != nil {
+				return newSignStateCorruptedError(s.db.Path(), err)
+			}
+			// a later asynchronous save has already moved the watermark on
+			err = current.GetErrorIfLessOrEqual(hrs.Height, hrs.Round, hrs.Step, nil)
+			var sameHRSErr *SameHRSError
+			if err != nil && !errors.As(err, &sameHRSErr) {
+				return nil
+			}
+		}
+		if err := bucket.Put(boltWatermarkKey, watermark); err != nil {
+			return err
+		}
+
+		history := tx.Bucket(boltHistoryBucket)
+		if err := history.Put(boltHistoryKey(hrs), entry); err != nil {
+			return err
+		}
+		// keep the same blocks as the cache of the sign state
+		if hrs.Height <= blocksToCache {
+			return nil
+		}
+		minKey := boltHistoryKey(HRSKey{Height: hrs.Height - blocksToCache})
+		var expired [][]byte
+		c := history.Cursor()
+		for k, _ := c.First(); k != nil && bytes.Compare(k, minKey) < 0; k, _ = c.Next() {
+			expired = append(expired, k)
+		}
+		for _, k := range expired {
+			if err := history.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *boltSignStateStore) Close() error {
+	return s.db.Close()
+}
+
+// ConvertSignState copies the sign state at srcPath to a new sign state file at dstPath,
+// i.e. to migrate from a JSON file to a BoltDB database. The sign state at srcPath is left as is.
+func ConvertSignState(srcPath, dstPath string) error {
+	src, err := LoadSignState(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	if _, err := os.Stat(dstPath); err == nil {
+		return fmt.Errorf("%s already exists", dstPath)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	store, err := openSignStateStore(dstPath)
+	if err != nil {
+		return err
+	}
+	err = store.Save(&src)
+	if closeErr := store.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("error saving sign state to %s: %w", dstPath, err)
+	}
+
+	dst, err := LoadSignState(dstPath)
+	if err != nil {
+		return err
+	}
+	defer dst.Close()
+	if dst.Height != src.Height || dst.Round != src.Round || dst.Step != src.Step {
+		return fmt.Errorf("sign state of %s differs from %s after converting", dstPath, srcPath)
+	}
+	return nil
+}
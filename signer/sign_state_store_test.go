@@ -0,0 +1,187 @@
+package signer
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestBoltSignStateStore(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "state.db")
+
+	_, err := LoadSignState(file)
+	require.True(t, os.IsNotExist(err))
+	_, err = os.Stat(file)
+	require.True(t, os.IsNotExist(err), "loading must not create the database")
+
+	signState, err := LoadOrCreateSignState(file)
+	require.NoError(t, err)
+	for height := int64(1); height <= 10; height++ {
+		ssc := SignStateConsensus{Height: height, Step: stepPrecommit, Signature: []byte{byte(height)}}
+		require.NoError(t, signState.Save(ssc, nil, false))
+	}
+
+	// an asynchronous save that is overtaken leaves the watermark alone
+	behind := signState
+	behind.Height = 9
+	require.NoError(t, signState.store.Save(&behind))
+
+	require.NoError(t, signState.Close())
+
+	loaded, err := LoadSignState(file)
+	require.NoError(t, err)
+	defer loaded.Close()
+	require.Equal(t, int64(10), loaded.Height)
+	require.Equal(t, []byte{10}, loaded.Signature)
+
+	// the history restores the cache of the recently signed blocks
+	_, ssc := loaded.GetFromCache(HRSKey{Height: 8, Step: stepPrecommit}, nil)
+	require.NotNil(t, ssc)
+	require.Equal(t, []byte{8}, ssc.Signature)
+	require.Len(t, loaded.cache, blocksToCache+1)
+}
+
+func TestConvertSignState(t *testing.T) {
+	dir := t.TempDir()
+	jsonFile := filepath.Join(dir, "state.json")
+	boltFile := filepath.Join(dir, "state.db")
+
+	signState, err := LoadOrCreateSignState(jsonFile)
+	require.NoError(t, err)
+	require.NoError(t, signState.Save(NewSignStateConsensus(100, 1, stepPrevote), nil, false))
+
+	require.NoError(t, ConvertSignState(jsonFile, boltFile))
+	converted, err := LoadSignState(boltFile)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), converted.Height)
+	require.Equal(t, int64(1), converted.Round)
+	require.Equal(t, stepPrevote, converted.Step)
+	require.NoError(t, converted.Close())
+
+	// an existing sign state is never overwritten
+	require.Error(t, ConvertSignState(jsonFile, boltFile))
+}
+
+func TestBoltSignStateWithoutVersion(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "state.db")
+	store, err := openBoltSignStateStore(file)
+	require.NoError(t, err)
+	err = store.db.Update(func(tx *bolt.Tx) error {
+		return tx.Bucket(boltSignStateBucket).Put(boltWatermarkKey, []byte(`{"height":"1500000","round":"0","step":3}`))
+	})
+	require.NoError(t, err)
+	require.NoError(t, store.Close())
+
+	// BoltDB sign states were always written with a version
+	_, err = LoadSignState(file)
+	var corruptedErr *SignStateCorruptedError
+	require.True(t, errors.As(err, &corruptedErr))
+}
+
+func TestLoadSignStateCorrupted(t *testing.T) {
+	dir := t.TempDir()
+	signState, err := LoadOrCreateSignState(filepath.Join(dir, "valid.json"))
+	require.NoError(t, err)
+	require.NoError(t, signState.Save(NewSignStateConsensus(1500000, 0, stepPrecommit), nil, false))
+	valid, err := os.ReadFile(filepath.Join(dir, "valid.json"))
+	require.NoError(t, err)
+	require.Contains(t, string(valid), `"version": 1`)
+
+	tcs := []struct {
+		name      string
+		contents  string
+		expectErr bool
+	}{
+		{
+			name:     "valid",
+			contents: string(valid),
+		},
+		{
+			name:     "without version",
+			contents: `{"height":"1500000","round":"0","step":3}`,
+		},
+		{
+			name:      "checksum without version",
+			contents:  strings.Replace(string(valid), `"version": 1,`, "", 1),
+			expectErr: true,
+		},
+		{
+			name:      "empty",
+			contents:  "",
+			expectErr: true,
+		},
+		{
+			name:      "truncated",
+			contents:  string(valid[:len(valid)/2]),
+			expectErr: true,
+		},
+		{
+			name:      "edited height",
+			contents:  strings.Replace(string(valid), `"1500000"`, `"1400000"`, 1),
+			expectErr: true,
+		},
+		{
+			name:      "missing height",
+			contents:  `{"round":"0","step":3}`,
+			expectErr: true,
+		},
+		{
+			name:      "newer version",
+			contents:  strings.Replace(string(valid), `"version": 1`, `"version": 2`, 1),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			file := filepath.Join(dir, strings.ReplaceAll(tc.name, " ", "_")+".json")
+			require.NoError(t, os.WriteFile(file, []byte(tc.contents), 0600))
+
+			signState, err := LoadOrCreateSignState(file)
+			if !tc.expectErr {
+				require.NoError(t, err)
+				require.Equal(t, int64(1500000), signState.Height)
+
+				// a sign state without version is left as it is until it is migrated
+				contents, err := os.ReadFile(file)
+				require.NoError(t, err)
+				require.Equal(t, tc.contents, string(contents))
+
+				migrated, err := signState.MigrateLegacy()
+				require.NoError(t, err)
+				require.Equal(t, tc.name == "without version", migrated)
+				contents, err = os.ReadFile(file)
+				require.NoError(t, err)
+				require.Contains(t, string(contents), `"checksum"`)
+
+				loaded, err := LoadSignState(file)
+				require.NoError(t, err)
+				require.Equal(t, int64(1500000), loaded.Height)
+				migrated, err = loaded.MigrateLegacy()
+				require.NoError(t, err)
+				require.False(t, migrated)
+				return
+			}
+			var corruptedErr *SignStateCorruptedError
+			require.True(t, errors.As(err, &corruptedErr))
+
+			// the corrupted sign state is left for recovery
+			contents, err := os.ReadFile(file)
+			require.NoError(t, err)
+			require.Equal(t, tc.contents, string(contents))
+		})
+	}
+}
+
+// testSignStateFile returns the path of a new sign state file in the temporary directory of the test.
+// The asynchronous saves of the sign state are waited for before the directory is removed.
+func testSignStateFile(t *testing.T, name string) string {
+	dir := t.TempDir()
+	t.Cleanup(asyncSignStateSaves.Wait)
+	return filepath.Join(dir, name)
+}
@@ -0,0 +1,241 @@
+package signer
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	proto "github.com/strangelove-ventures/horcrux/signer/proto"
+	tmLog "github.com/tendermint/tendermint/libs/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// number of heights below the latest signed one for which the requesting sentry is remembered
+const slashingRiskHistoryHeights = 3
+
+// ConflictingSignBytes is one of the two sign bytes of a conflicting sign request, decoded for the conflict log
+type ConflictingSignBytes struct {
+	// address of the sentry that sent the sign request, empty if unknown
+	Sentry string `json:"sentry,omitempty"`
+
+	Type          string    `json:"type,omitempty"`
+	ChainID       string    `json:"chain_id,omitempty"`
+	BlockID       string    `json:"block_id,omitempty"`
+	PartSetHeader string    `json:"part_set_header,omitempty"`
+	POLRound      int64     `json:"pol_round"`
+	Timestamp     time.Time `json:"timestamp"`
+	SignBytes     string    `json:"sign_bytes"`
+	DecodeError   string    `json:"decode_error,omitempty"`
+}
+
+// ConflictingRequest is a sign request that was refused because it conflicts with an already signed block
+type ConflictingRequest struct {
+	Time     time.Time            `json:"time"`
+	Height   int64                `json:"height"`
+	Round    int64                `json:"round"`
+	Step     int8                 `json:"step"`
+	Reason   string               `json:"reason"`
+	Existing ConflictingSignBytes `json:"existing"`
+	New      ConflictingSignBytes `json:"new"`
+}
+
+func newConflictingSignBytes(signBytes []byte) ConflictingSignBytes {
+	c := ConflictingSignBytes{SignBytes: hex.EncodeToString(signBytes)}
+	content, err := DecodeSignBytes(signBytes)
+	if err != nil {
+		c.DecodeError = err.Error()
+		return c
+	}
+	c.Type = content.Type.String()
+	c.ChainID = content.ChainID
+	c.POLRound = content.POLRound
+	c.Timestamp = content.Timestamp()
+	if content.BlockID != nil {
+		c.BlockID = content.BlockIDHash()
+		c.PartSetHeader = content.PartSetHeader()
+	}
+	return c
+}
+
+type signedRequest struct {
+	sentry    string
+	signBytes []byte
+}
+
+// SlashingRiskDetector records sign requests from the sentries that were refused because signing them
+// would be a double sign, together with the sentries that sent the conflicting requests.
+// A conflicting request usually means a second validator with the same key or a compromised sentry.
+type SlashingRiskDetector struct {
+	logger   tmLog.Logger
+	filePath string
+
+	mu sync.Mutex
+
+	// sentries of the recently signed sign bytes
+	signed map[HRSKey]signedRequest
+}
+
+// NewSlashingRiskDetector returns a new SlashingRiskDetector appending conflicting requests to the file
+func NewSlashingRiskDetector(logger tmLog.Logger, filePath string) *SlashingRiskDetector {
+	return &SlashingRiskDetector{
+		logger:   logger,
+		filePath: filePath,
+		signed:   make(map[HRSKey]signedRequest),
+	}
+}
+
+// RecordSigned remembers the sentry that requested the signed sign bytes, so that it can be
+// reported if a conflicting request for the same height, round and step arrives later.
+func (d *SlashingRiskDetector) RecordSigned(sentry string, signBytes []byte) {
+	hrst, err := UnpackHRST(signBytes)
+	if err != nil {
+		return
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.signed[HRSKey{hrst.Height, hrst.Round, hrst.Step}] = signedRequest{sentry: sentry, signBytes: signBytes}
+	for hrs := range d.signed {
+		if hrs.Height < hrst.Height-slashingRiskHistoryHeights {
+			delete(d.signed, hrs)
+		}
+	}
+}
+
+// RecordConflict records a conflicting sign request from the sentry, updates the metrics
+// and fires the conflict alert.
+func (d *SlashingRiskDetector) RecordConflict(sentry string, conflict *ConflictingDataError) {
+	req := ConflictingRequest{
+		Time:     time.Now().UTC(),
+		Reason:   conflict.Reason,
+		Existing: newConflictingSignBytes(conflict.ExistingSignBytes),
+		New:      newConflictingSignBytes(conflict.NewSignBytes),
+	}
+	req.New.Sentry = sentry
+	if hrst, err := UnpackHRST(conflict.NewSignBytes); err == nil {
+		req.Height, req.Round, req.Step = hrst.Height, hrst.Round, hrst.Step
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if signed, ok := d.signed[HRSKey{req.Height, req.Round, req.Step}]; ok &&
+		bytes.Equal(signed.signBytes, conflict.ExistingSignBytes) {
+		req.Existing.Sentry = signed.sentry
+	}
+
+	totalConflictingRequests.WithLabelValues(sentry).Inc()
+	lastConflictingRequestHeight.Set(float64(req.Height))
+
+	d.logger.Error("Refused conflicting sign request, possible double sign attempt",
+		"sentry", sentry,
+		"height", req.Height,
+		"round", req.Round,
+		"step", req.Step,
+		"reason", req.Reason,
+		"existing_sentry", req.Existing.Sentry,
+		"existing_block_id", req.Existing.BlockID,
+		"new_block_id", req.New.BlockID,
+	)
+
+	if err := d.append(req); err != nil {
+		d.logger.Error("Failed to write conflicting sign request to log", "file", d.filePath, "error", err)
+	}
+
+	fireAlert(Alert{
+		Event:    AlertConflictingRequest,
+		Severity: AlertCritical,
+		Summary: fmt.Sprintf("Refused conflicting sign request for %d.%d.%d from sentry %s, possible double sign attempt",
+			req.Height, req.Round, req.Step, sentry),
+		Key: sentry,
+		Details: map[string]string{
+			"sentry":            sentry,
+			"height":            fmt.Sprint(req.Height),
+			"round":             fmt.Sprint(req.Round),
+			"step":              fmt.Sprint(req.Step),
+			"reason":            req.Reason,
+			"existing_sentry":   req.Existing.Sentry,
+			"existing_block_id": req.Existing.BlockID,
+			"new_block_id":      req.New.BlockID,
+		},
+	})
+}
+
+// append writes the conflicting request as a JSON line to the log file
+func (d *SlashingRiskDetector) append(req ConflictingRequest) error {
+	if d.filePath == "" {
+		return nil
+	}
+	line, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	f, err := os.OpenFile(d.filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ReadConflictingRequests returns the conflicting sign requests in the log file, oldest first.
+// Returns no requests if the file does not exist.
+func ReadConflictingRequests(filePath string) ([]ConflictingRequest, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	var requests []ConflictingRequest
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		var req ConflictingRequest
+		if err := json.Unmarshal(scanner.Bytes(), &req); err != nil {
+			return nil, fmt.Errorf("line %d of %s: %w", line, filePath, err)
+		}
+		requests = append(requests, req)
+	}
+	return requests, scanner.Err()
+}
+
+// conflictingDataErrorToRemote returns the gRPC error for a ConflictingDataError of the raft leader,
+// carrying the sign bytes in a ConflictingData status detail since the error type is lost over gRPC.
+func conflictingDataErrorToRemote(conflict *ConflictingDataError) error {
+	st, err := status.New(codes.Unknown, conflict.Error()).WithDetails(&proto.ConflictingData{
+		Reason:            conflict.Reason,
+		ExistingSignBytes: conflict.ExistingSignBytes,
+		NewSignBytes:      conflict.NewSignBytes,
+	})
+	if err != nil {
+		return conflict
+	}
+	return st.Err()
+}
+
+// conflictingDataErrorFromRemote recovers a ConflictingDataError returned by the raft leader
+// from the ConflictingData status detail of the gRPC error.
+func conflictingDataErrorFromRemote(err error) (*ConflictingDataError, bool) {
+	for _, detail := range status.Convert(err).Details() {
+		if conflict, ok := detail.(*proto.ConflictingData); ok {
+			return newConflictingDataError(conflict.Reason, conflict.ExistingSignBytes, conflict.NewSignBytes), true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,116 @@
+package signer
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tm "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func precommitSignBytes(height int64, round int32, blockHash []byte, stamp time.Time) []byte {
+	vote := tmproto.Vote{
+		Height:    height,
+		Round:     round,
+		Type:      tmproto.PrecommitType,
+		Timestamp: stamp,
+	}
+	if blockHash != nil {
+		vote.BlockID = tmproto.BlockID{
+			Hash:          blockHash,
+			PartSetHeader: tmproto.PartSetHeader{Total: 1, Hash: blockHash},
+		}
+	}
+	return tm.VoteSignBytes("chain-id", &vote)
+}
+
+// asConflictingDataError returns the error as ConflictingDataError, panics if it is not
+func asConflictingDataError(err error) *ConflictingDataError {
+	return err.(*ConflictingDataError)
+}
+
+func TestConflictingVoteIsConflictingDataError(t *testing.T) {
+	now := time.Now()
+	existing := precommitSignBytes(10, 0, []byte("01234567890123456789012345678901"), now)
+
+	err := checkVoteOnlyDifferByTimestamp(existing, precommitSignBytes(10, 0, nil, now))
+	require.IsType(t, &ConflictingDataError{}, err)
+
+	conflicting := precommitSignBytes(10, 0, []byte("abcdefghijabcdefghijabcdefghijab"), now)
+	err = checkVoteOnlyDifferByTimestamp(existing, conflicting)
+	require.IsType(t, &ConflictingDataError{}, err)
+	require.Equal(t, existing, err.(*ConflictingDataError).ExistingSignBytes)
+	require.Equal(t, conflicting, err.(*ConflictingDataError).NewSignBytes)
+
+	require.NoError(t, checkVoteOnlyDifferByTimestamp(existing,
+		precommitSignBytes(10, 0, []byte("01234567890123456789012345678901"), now.Add(time.Second))))
+}
+
+func TestSlashingRiskDetector(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "conflicting_requests.jsonl")
+	detector := NewSlashingRiskDetector(tmlog.NewNopLogger(), filePath)
+
+	now := time.Now()
+	blockHash := []byte("01234567890123456789012345678901")
+	existing := precommitSignBytes(10, 1, blockHash, now)
+	detector.RecordSigned("tcp://sentry-1:1234", existing)
+
+	conflicting := precommitSignBytes(10, 1, nil, now)
+	detector.RecordConflict("tcp://sentry-2:1234",
+		asConflictingDataError(checkVoteOnlyDifferByTimestamp(existing, conflicting)))
+
+	requests, err := ReadConflictingRequests(filePath)
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+
+	req := requests[0]
+	require.Equal(t, int64(10), req.Height)
+	require.Equal(t, int64(1), req.Round)
+	require.Equal(t, stepPrecommit, req.Step)
+	require.Equal(t, "tcp://sentry-1:1234", req.Existing.Sentry)
+	require.Equal(t, "tcp://sentry-2:1234", req.New.Sentry)
+	require.Equal(t, "SIGNED_MSG_TYPE_PRECOMMIT", req.New.Type)
+	require.Equal(t, "chain-id", req.New.ChainID)
+	require.Equal(t, "3031323334353637383930313233343536373839303132333435363738393031", req.Existing.BlockID)
+	require.Empty(t, req.New.BlockID)
+
+	// signed sign bytes of old heights are forgotten
+	detector.RecordSigned("tcp://sentry-1:1234", precommitSignBytes(20, 0, blockHash, now))
+	detector.RecordConflict("tcp://sentry-2:1234",
+		asConflictingDataError(checkVoteOnlyDifferByTimestamp(existing, conflicting)))
+	requests, err = ReadConflictingRequests(filePath)
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+	require.Empty(t, requests[1].Existing.Sentry)
+}
+
+func TestReadConflictingRequestsMissingFile(t *testing.T) {
+	requests, err := ReadConflictingRequests(filepath.Join(t.TempDir(), "missing.jsonl"))
+	require.NoError(t, err)
+	require.Empty(t, requests)
+}
+
+func TestConflictingDataErrorFromRemote(t *testing.T) {
+	now := time.Now()
+	existing := precommitSignBytes(10, 0, []byte("01234567890123456789012345678901"), now)
+	conflicting := precommitSignBytes(10, 0, nil, now)
+	conflict := asConflictingDataError(checkVoteOnlyDifferByTimestamp(existing, conflicting))
+
+	// the sign bytes are carried in a status detail of the gRPC error
+	remote, ok := conflictingDataErrorFromRemote(conflictingDataErrorToRemote(conflict))
+	require.True(t, ok)
+	require.Equal(t, conflict, remote)
+
+	// the error message of the leader alone is not parsed
+	_, ok = conflictingDataErrorFromRemote(status.Error(codes.Unknown, conflict.Error()))
+	require.False(t, ok)
+
+	_, ok = conflictingDataErrorFromRemote(errors.New("raft not yet initialized"))
+	require.False(t, ok)
+}
@@ -2,9 +2,11 @@ package signer
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"fmt"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/raft"
@@ -35,21 +37,54 @@ type ThresholdValidator struct {
 	cosigner Cosigner
 
 	// peer cosigners
-	peers []Cosigner
+	peers      []Cosigner
+	peersMutex sync.Mutex
 
 	raftStore *RaftStore
 
+	// when true, any cosigner may coordinate a signing round and raft is not used.
+	// Double sign protection relies on the watermarks of each LocalCosigner.
+	leaderless bool
+
+	// number of SignBlock calls in progress
+	inFlight int32
+
+	// maximum time the timestamp of a block may be ahead of the local clock, not checked if zero
+	maxClockSkew time.Duration
+
+	// records the combined signatures, may be nil
+	auditLog *AuditLog
+
+	// refuses sign requests while paused by an operator, may be nil
+	pause *SigningPause
+
+	// refuses sign requests above the halt height of a chain upgrade, may be nil
+	upgrade *ChainUpgrade
+
 	logger log.Logger
 }
 
 type ThresholdValidatorOpt struct {
-	Pubkey    crypto.PubKey
-	Threshold int
-	SignState SignState
-	Cosigner  Cosigner
-	Peers     []Cosigner
-	RaftStore *RaftStore
-	Logger    log.Logger
+	Pubkey     crypto.PubKey
+	Threshold  int
+	SignState  SignState
+	Cosigner   Cosigner
+	Peers      []Cosigner
+	RaftStore  *RaftStore
+	Leaderless bool
+	Logger     log.Logger
+
+	// maximum time the timestamp of a block may be ahead of the local clock, not checked if zero
+	MaxClockSkew time.Duration
+
+	// records the combined signatures, may be nil
+	AuditLog *AuditLog
+
+	// refuses sign requests while paused by an operator, may be nil
+	Pause *SigningPause
+
+	// refuses sign requests above the halt height of a chain upgrade, may be nil
+	Upgrade *ChainUpgrade
 }
 
 // NewThresholdValidator creates and returns a new ThresholdValidator
@@ -62,18 +97,47 @@ func NewThresholdValidator(opt *ThresholdValidatorOpt) *ThresholdValidator {
 	validator.lastSignState = opt.SignState
 	validator.lastSignStateMutex = sync.Mutex{}
 	validator.lastSignStateInitiated = SignState{
-		Height:   opt.SignState.Height,
-		Round:    opt.SignState.Round,
-		Step:     opt.SignState.Step,
-		filePath: "none",
-		cache:    make(map[HRSKey]SignStateConsensus),
+		Height: opt.SignState.Height,
+		Round:  opt.SignState.Round,
+		Step:   opt.SignState.Step,
+		store:  noopSignStateStore{},
+		cache:  make(map[HRSKey]SignStateConsensus),
 	}
 	validator.lastSignStateInitiatedMutex = sync.Mutex{}
 	validator.raftStore = opt.RaftStore
+	validator.leaderless = opt.Leaderless
 	validator.logger = opt.Logger
+	validator.maxClockSkew = opt.MaxClockSkew
+	validator.auditLog = opt.AuditLog
+	validator.pause = opt.Pause
+	validator.upgrade = opt.Upgrade
 	return validator
 }
 
+// SetPeers replaces the peer cosigners used for signing rounds
+func (pv *ThresholdValidator) SetPeers(peers []Cosigner) {
+	pv.peersMutex.Lock()
+	defer pv.peersMutex.Unlock()
+	pv.peers = peers
+}
+
+func (pv *ThresholdValidator) getPeers() []Cosigner {
+	pv.peersMutex.Lock()
+	defer pv.peersMutex.Unlock()
+	return pv.peers
+}
+
+// lastSignedHRS returns the height, round and step of the last signed block
+func (pv *ThresholdValidator) lastSignedHRS() HRSTKey {
+	pv.lastSignStateMutex.Lock()
+	defer pv.lastSignStateMutex.Unlock()
+	return HRSTKey{
+		Height: pv.lastSignState.Height,
+		Round:  pv.lastSignState.Round,
+		Step:   pv.lastSignState.Step,
+	}
+}
+
 func (pv *ThresholdValidator) SaveLastSignedState(signState SignStateConsensus) error {
 	return pv.lastSignState.Save(signState, &pv.lastSignStateMutex, true)
 }
@@ -91,13 +155,20 @@ func (pv *ThresholdValidator) GetPubKey() (crypto.PubKey, error) {
 // SignVote signs a canonical representation of the vote, along with the
 // chainID. Implements PrivValidator.
 func (pv *ThresholdValidator) SignVote(chainID string, vote *tmProto.Vote) error {
+	return pv.SignVoteFrom("", chainID, vote)
+}
+
+// SignVoteFrom signs the vote like SignVote, recording the sentry that requested it in the audit log
+func (pv *ThresholdValidator) SignVoteFrom(source, chainID string, vote *tmProto.Vote) error {
 	block := &Block{
 		Height:    vote.Height,
 		Round:     int64(vote.Round),
 		Step:      VoteToStep(vote),
 		Timestamp: vote.Timestamp,
 		SignBytes: tm.VoteSignBytes(chainID, vote),
+		Source:    source,
 	}
+	pv.recordSignRequest(block)
 	sig, stamp, err := pv.SignBlock(chainID, block)
 
 	vote.Signature = sig
@@ -109,13 +180,20 @@ func (pv *ThresholdValidator) SignVote(chainID string, vote *tmProto.Vote) error
 // SignProposal signs a canonical representation of the proposal, along with
 // the chainID. Implements PrivValidator.
 func (pv *ThresholdValidator) SignProposal(chainID string, proposal *tmProto.Proposal) error {
+	return pv.SignProposalFrom("", chainID, proposal)
+}
+
+// SignProposalFrom signs the proposal like SignProposal, recording the sentry that requested it in the audit log
+func (pv *ThresholdValidator) SignProposalFrom(source, chainID string, proposal *tmProto.Proposal) error {
 	block := &Block{
 		Height:    proposal.Height,
 		Round:     int64(proposal.Round),
 		Step:      ProposalToStep(proposal),
 		Timestamp: proposal.Timestamp,
 		SignBytes: tm.ProposalSignBytes(chainID, proposal),
+		Source:    source,
 	}
+	pv.recordSignRequest(block)
 	sig, stamp, err := pv.SignBlock(chainID, block)
 
 	proposal.Signature = sig
@@ -124,12 +202,24 @@ func (pv *ThresholdValidator) SignProposal(chainID string, proposal *tmProto.Pro
 	return err
 }
 
+// recordSignRequest records the arrival of a sign request from our sentries for leader placement.
+// Requests forwarded by other cosigners are not recorded, every cosigner reports its own arrivals.
+func (pv *ThresholdValidator) recordSignRequest(block *Block) {
+	if pv.raftStore == nil {
+		return
+	}
+	pv.raftStore.recordSignRequest(HRSKey{Height: block.Height, Round: block.Round, Step: block.Step})
+}
+
 type Block struct {
 	Height    int64
 	Round     int64
 	Step      int8
 	SignBytes []byte
 	Timestamp time.Time
+
+	// sentry that requested the signature, or the cosigner that forwarded the request, if known
+	Source string
 }
 
 func (block Block) toProto() *proto.Block {
@@ -139,6 +229,7 @@ func (block Block) toProto() *proto.Block {
 		Step:      int32(block.Step),
 		SignBytes: block.SignBytes,
 		Timestamp: block.Timestamp.UnixNano(),
+		Source:    block.Source,
 	}
 }
 
@@ -299,16 +390,47 @@ func (pv *ThresholdValidator) getExistingBlockSignature(block *Block) ([]byte, t
 }
 
 func (pv *ThresholdValidator) SignBlock(chainID string, block *Block) ([]byte, time.Time, error) {
-	height, round, step, stamp, signBytes := block.Height, block.Round, block.Step, block.Timestamp, block.SignBytes
+	atomic.AddInt32(&pv.inFlight, 1)
+	defer atomic.AddInt32(&pv.inFlight, -1)
+
+	stamp := block.Timestamp
+
+	if err := pv.pause.check(block.Height); err != nil {
+		return nil, stamp, err
+	}
 
 	timeStartSignBlock := time.Now()
 
+	if pv.leaderless {
+		pv.logger.Debug("Leaderless mode. Managing the sign process for this block")
+		totalLeaderlessSignRounds.Inc()
+		return pv.signBlock(chainID, block, timeStartSignBlock)
+	}
+
 	// Only the leader can execute this function. Followers can handle the requests,
 	// but they just need to proxy the request to the raft leader
 	if pv.raftStore.raft == nil {
 		return nil, stamp, errors.New("raft not yet initialized")
 	}
 	if pv.raftStore.raft.State() != raft.Leader {
+		// The LSS replicated from the leader may already hold the signature for this block
+		existingSignature, existingTimestamp, err := pv.getExistingBlockSignature(block)
+		if err == nil && existingSignature != nil {
+			pv.logger.Debug("I am not the raft leader. Returning signature from LSS cache")
+			totalFollowerCacheHits.Inc()
+			return existingSignature, existingTimestamp, nil
+		}
+		switch err.(type) {
+		case nil:
+			// same block that only differs by timestamp, the leader needs to sign again
+		case *StillWaitingForBlockError:
+			// block not signed yet, the leader needs to sign it
+		default:
+			// regression or conflicting data, the leader would reject this request as well
+			totalFollowerCacheRejections.Inc()
+			return nil, existingTimestamp, err
+		}
+
 		pv.logger.Debug("I am not the raft leader. Proxying request to the leader")
 		totalNotRaftLeader.Inc()
 		signRes, err := pv.raftStore.LeaderSignBlock(CosignerSignBlockRequest{chainID, block})
@@ -320,6 +442,10 @@ func (pv *ThresholdValidator) SignBlock(chainID string, block *Block) ([]byte, t
 					return nil, stamp, &BeyondBlockError{msg: rpcErrUnwrapped}
 				}
 			}
+			// Same for conflicting data, which is recorded by the slashing risk detector
+			if conflict, ok := conflictingDataErrorFromRemote(err); ok {
+				return nil, stamp, conflict
+			}
 			return nil, stamp, err
 		}
 		return signRes.Signature, stamp, nil
@@ -328,6 +454,18 @@ func (pv *ThresholdValidator) SignBlock(chainID string, block *Block) ([]byte, t
 	totalRaftLeader.Inc()
 	pv.logger.Debug("I am the raft leader. Managing the sign process for this block")
 
+	return pv.signBlock(chainID, block, timeStartSignBlock)
+}
+
+// signBlock coordinates a threshold signing round for the block with our cosigner and the peers.
+// In raft mode this is only executed by the leader, in leaderless mode by any cosigner.
+func (pv *ThresholdValidator) signBlock(
+	chainID string,
+	block *Block,
+	timeStartSignBlock time.Time,
+) ([]byte, time.Time, error) {
+	height, round, step, stamp, signBytes := block.Height, block.Round, block.Step, block.Timestamp, block.SignBytes
+
 	hrst := HRSTKey{
 		Height:    height,
 		Round:     round,
@@ -335,6 +473,18 @@ func (pv *ThresholdValidator) SignBlock(chainID string, block *Block) ([]byte, t
 		Timestamp: stamp.UnixNano(),
 	}
 
+	if err := checkClockSkew(pv.maxClockSkew, stamp); err != nil {
+		return nil, stamp, err
+	}
+
+	if err := pv.upgrade.check(chainID, height); err != nil {
+		if _, ok := err.(*HaltHeightError); ok {
+			// the chain halted, the sentries are already running the new chain
+			pv.startChainUpgrade()
+		}
+		return nil, stamp, err
+	}
+
 	// Keep track of the last block that we began the signing process for. Only allow one attempt per block
 	if err := pv.SaveLastSignedStateInitiated(NewSignStateConsensus(height, round, step)); err != nil {
 		switch err.(type) {
@@ -375,20 +525,31 @@ func (pv *ThresholdValidator) SignBlock(chainID string, block *Block) ([]byte, t
 		}
 	}
 
-	numPeers := len(pv.peers)
-	total := uint8(numPeers + 1)
+	peers := pv.getPeers()
+	ourID := pv.cosigner.GetID()
+
+	// total must cover the highest share ID, which can exceed the number of
+	// cosigners after peers have been removed from the cluster
+	total := uint8(len(peers) + 1)
+	if int(total) < ourID {
+		total = uint8(ourID)
+	}
+	for _, peer := range peers {
+		if int(total) < peer.GetID() {
+			total = uint8(peer.GetID())
+		}
+	}
+
 	getEphemeralWaitGroup := sync.WaitGroup{}
 
 	// Only wait until we have threshold sigs
 	getEphemeralWaitGroup.Add(pv.threshold - 1)
 	// Used to track how close we are to threshold
 
-	ourID := pv.cosigner.GetID()
-
 	encryptedEphemeralSharesThresholdMap := make(map[Cosigner][]CosignerEphemeralSecretPart)
 	thresholdPeersMutex := sync.Mutex{}
 
-	for _, peer := range pv.peers {
+	for _, peer := range peers {
 		go pv.waitForPeerEphemeralShares(peer, hrst, &getEphemeralWaitGroup,
 			&encryptedEphemeralSharesThresholdMap, &thresholdPeersMutex)
 	}
@@ -456,6 +617,18 @@ func (pv *ThresholdValidator) SignBlock(chainID string, block *Block) ([]byte, t
 
 	if len(sigIds) < pv.threshold {
 		totalInsufficientCosigners.Inc()
+		fireAlert(Alert{
+			Event:    AlertInsufficientCosigners,
+			Severity: AlertError,
+			Summary: fmt.Sprintf("Only %d of %d cosigners needed signed block %d.%d.%d",
+				len(sigIds), pv.threshold, height, round, step),
+			Details: map[string]string{
+				"height":    fmt.Sprint(height),
+				"round":     fmt.Sprint(round),
+				"step":      fmt.Sprint(step),
+				"cosigners": fmt.Sprint(sigIds),
+			},
+		})
 		return nil, stamp, errors.New("not enough co-signers")
 	}
 
@@ -468,6 +641,18 @@ func (pv *ThresholdValidator) SignBlock(chainID string, block *Block) ([]byte, t
 	// verify the combined signature before saving to watermark
 	if !pv.pubkey.VerifySignature(signBytes, signature) {
 		totalInvalidSignature.Inc()
+		fireAlert(Alert{
+			Event:    AlertInvalidSignature,
+			Severity: AlertCritical,
+			Summary: fmt.Sprintf("Combined signature of block %d.%d.%d is not valid, a cosigner may have a wrong key share",
+				height, round, step),
+			Details: map[string]string{
+				"height":    fmt.Sprint(height),
+				"round":     fmt.Sprint(round),
+				"step":      fmt.Sprint(step),
+				"cosigners": fmt.Sprint(sigIds),
+			},
+		})
 		return nil, stamp, errors.New("combined signature is not valid")
 	}
 
@@ -487,13 +672,72 @@ func (pv *ThresholdValidator) SignBlock(chainID string, block *Block) ([]byte, t
 	}
 
 	// Emit last signed state to cluster
-	err = pv.raftStore.Emit(raftEventLSS, newLss)
-	if err != nil {
-		pv.logger.Error("Error emitting LSS", err.Error())
+	if !pv.leaderless {
+		err = pv.raftStore.Emit(raftEventLSS, lssEvent{SignStateConsensus: newLss, ChainID: chainID})
+		if err != nil {
+			pv.logger.Error("Error emitting LSS", err.Error())
+		}
 	}
 
-	timeSignBlock := time.Since(timeStartSignBlock).Seconds()
-	timedSignBlockLag.Observe(timeSignBlock)
+	timeSignBlock := time.Since(timeStartSignBlock)
+	timedSignBlockLag.Observe(timeSignBlock.Seconds())
+
+	pv.auditLog.recordSignature(AuditRoleValidator, signBytes, sigIds, block.Source, timeSignBlock)
 
 	return signature, stamp, nil
 }
+
+// waitForSignBlocks waits until no SignBlock call is in progress, or the context is done.
+func (pv *ThresholdValidator) waitForSignBlocks(ctx context.Context) error {
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for atomic.LoadInt32(&pv.inFlight) > 0 {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%d sign requests still in progress: %w", atomic.LoadInt32(&pv.inFlight), ctx.Err())
+		case <-ticker.C:
+		}
+	}
+	return nil
+}
+
+// startChainUpgrade switches all cosigners to the new chain of the upgrade plan through raft,
+// or only this cosigner in leaderless mode
+func (pv *ThresholdValidator) startChainUpgrade() {
+	plan := pv.upgrade.Plan()
+	if plan == nil {
+		return
+	}
+	pv.logger.Info("Chain halted for upgrade, switching chain", "chain_id", plan.ChainID,
+		"halt_height", plan.HaltHeight, "new_chain_id", plan.NewChainID, "start_height", plan.StartHeight)
+	if pv.leaderless {
+		cosigner, _ := pv.cosigner.(*LocalCosigner)
+		if err := pv.upgrade.apply(*plan, pv, cosigner); err != nil {
+			pv.logger.Error("Failed to switch chain", "error", err)
+		}
+		return
+	}
+	if err := pv.raftStore.Emit(raftEventChainUpgrade, plan); err != nil {
+		pv.logger.Error("Error emitting chain upgrade", "error", err)
+	}
+}
+
+// switchChain starts the sign state of a new chain at the height
+func (pv *ThresholdValidator) switchChain(filePath string, height int64) error {
+	if err := pv.lastSignState.switchChain(filePath, height, &pv.lastSignStateMutex); err != nil {
+		return err
+	}
+	hrs := pv.lastSignedHRS()
+	pv.lastSignStateInitiatedMutex.Lock()
+	defer pv.lastSignStateInitiatedMutex.Unlock()
+	pv.lastSignStateInitiated.Height = hrs.Height
+	pv.lastSignStateInitiated.Round = hrs.Round
+	pv.lastSignStateInitiated.Step = hrs.Step
+	pv.lastSignStateInitiated.cache = make(map[HRSKey]SignStateConsensus)
+	return nil
+}
+
+// flushSignState synchronously persists the last sign state
+func (pv *ThresholdValidator) flushSignState() {
+	pv.lastSignState.Flush(&pv.lastSignStateMutex)
+}
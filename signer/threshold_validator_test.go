@@ -1,8 +1,11 @@
 package signer
 
 import (
+	"context"
 	"crypto/rand"
 	"crypto/rsa"
+	"fmt"
+	"sync/atomic"
 	"time"
 
 	"os"
@@ -60,11 +63,9 @@ func TestThresholdValidator2of2(t *testing.T) {
 		ID:       1,
 	}
 
-	stateFile1, err := os.CreateTemp("", "state1.json")
-	require.NoError(t, err)
-	defer os.Remove(stateFile1.Name())
+	stateFile1 := testSignStateFile(t, "state1.json")
 
-	signState1, err := LoadOrCreateSignState(stateFile1.Name())
+	signState1, err := LoadOrCreateSignState(stateFile1)
 	require.NoError(t, err)
 
 	key2 := CosignerKey{
@@ -73,10 +74,8 @@ func TestThresholdValidator2of2(t *testing.T) {
 		ID:       2,
 	}
 
-	stateFile2, err := os.CreateTemp("", "state2.json")
-	require.NoError(t, err)
-	defer os.Remove(stateFile2.Name())
-	signState2, err := LoadOrCreateSignState(stateFile2.Name())
+	stateFile2 := testSignStateFile(t, "state2.json")
+	signState2, err := LoadOrCreateSignState(stateFile2)
 	require.NoError(t, err)
 
 	config1 := LocalCosignerConfig{
@@ -183,11 +182,9 @@ func TestThresholdValidator3of3(t *testing.T) {
 		ID:       1,
 	}
 
-	stateFile1, err := os.CreateTemp("", "state1.json")
-	require.NoError(t, err)
-	defer os.Remove(stateFile1.Name())
+	stateFile1 := testSignStateFile(t, "state1.json")
 
-	signState1, err := LoadOrCreateSignState(stateFile1.Name())
+	signState1, err := LoadOrCreateSignState(stateFile1)
 	require.NoError(t, err)
 
 	key2 := CosignerKey{
@@ -196,11 +193,9 @@ func TestThresholdValidator3of3(t *testing.T) {
 		ID:       2,
 	}
 
-	stateFile2, err := os.CreateTemp("", "state2.json")
-	require.NoError(t, err)
-	defer os.Remove(stateFile2.Name())
+	stateFile2 := testSignStateFile(t, "state2.json")
 
-	signState2, err := LoadOrCreateSignState(stateFile2.Name())
+	signState2, err := LoadOrCreateSignState(stateFile2)
 	require.NoError(t, err)
 
 	key3 := CosignerKey{
@@ -209,11 +204,9 @@ func TestThresholdValidator3of3(t *testing.T) {
 		ID:       3,
 	}
 
-	stateFile3, err := os.CreateTemp("", "state3.json")
-	require.NoError(t, err)
-	defer os.Remove(stateFile3.Name())
+	stateFile3 := testSignStateFile(t, "state3.json")
 
-	signState3, err := LoadOrCreateSignState(stateFile3.Name())
+	signState3, err := LoadOrCreateSignState(stateFile3)
 	require.NoError(t, err)
 
 	config1 := LocalCosignerConfig{
@@ -335,11 +328,9 @@ func TestThresholdValidator2of3(t *testing.T) {
 		ID:       1,
 	}
 
-	stateFile1, err := os.CreateTemp("", "state1.json")
-	require.NoError(t, err)
-	defer os.Remove(stateFile1.Name())
+	stateFile1 := testSignStateFile(t, "state1.json")
 
-	signState1, err := LoadOrCreateSignState(stateFile1.Name())
+	signState1, err := LoadOrCreateSignState(stateFile1)
 	require.NoError(t, err)
 
 	key2 := CosignerKey{
@@ -348,11 +339,9 @@ func TestThresholdValidator2of3(t *testing.T) {
 		ID:       2,
 	}
 
-	stateFile2, err := os.CreateTemp("", "state2.json")
-	require.NoError(t, err)
-	defer os.Remove(stateFile2.Name())
+	stateFile2 := testSignStateFile(t, "state2.json")
 
-	signState2, err := LoadOrCreateSignState(stateFile2.Name())
+	signState2, err := LoadOrCreateSignState(stateFile2)
 	require.NoError(t, err)
 
 	key3 := CosignerKey{
@@ -361,11 +350,9 @@ func TestThresholdValidator2of3(t *testing.T) {
 		ID:       3,
 	}
 
-	stateFile3, err := os.CreateTemp("", "state3.json")
-	require.NoError(t, err)
-	defer os.Remove(stateFile3.Name())
+	stateFile3 := testSignStateFile(t, "state3.json")
 
-	signState3, err := LoadOrCreateSignState(stateFile3.Name())
+	signState3, err := LoadOrCreateSignState(stateFile3)
 	require.NoError(t, err)
 
 	config1 := LocalCosignerConfig{
@@ -449,3 +436,248 @@ func TestThresholdValidator2of3(t *testing.T) {
 
 	require.True(t, privateKey.PubKey().VerifySignature(signBytes, proposal.Signature))
 }
+
+func TestThresholdValidatorLeaderless2of3(t *testing.T) {
+	total := uint8(3)
+	threshold := uint8(2)
+
+	privateKey := tmCryptoEd25519.GenPrivKey()
+
+	privKeyBytes := [64]byte{}
+	copy(privKeyBytes[:], privateKey[:])
+	secretShares := tsed25519.DealShares(tsed25519.ExpandSecret(privKeyBytes[:32]), threshold, total)
+
+	rsaKeys := make([]*rsa.PrivateKey, total)
+	peers := make([]CosignerPeer, total)
+	for i := range rsaKeys {
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 4096)
+		require.NoError(t, err)
+		rsaKeys[i] = rsaKey
+		peers[i] = CosignerPeer{ID: i + 1, PublicKey: rsaKey.PublicKey}
+	}
+
+	cosigners := make([]Cosigner, total)
+	signStates := make([]SignState, total)
+	for i := range cosigners {
+		stateFile := testSignStateFile(t, "state.json")
+
+		signState, err := LoadOrCreateSignState(stateFile)
+		require.NoError(t, err)
+		signStates[i] = signState
+
+		cosigners[i] = NewLocalCosigner(LocalCosignerConfig{
+			CosignerKey: CosignerKey{
+				PubKey:   privateKey.PubKey(),
+				ShareKey: secretShares[i],
+				ID:       i + 1,
+			},
+			SignState: &signStates[i],
+			RsaKey:    *rsaKeys[i],
+			Peers:     peers,
+			Total:     total,
+			Threshold: threshold,
+		})
+	}
+
+	// two cosigners coordinate rounds for the sentries they are connected to, without raft
+	validator1 := NewThresholdValidator(&ThresholdValidatorOpt{
+		Pubkey:     privateKey.PubKey(),
+		Threshold:  int(threshold),
+		SignState:  signStates[0],
+		Cosigner:   cosigners[0],
+		Peers:      []Cosigner{cosigners[1], cosigners[2]},
+		Leaderless: true,
+		Logger:     tmlog.NewTMLogger(tmlog.NewSyncWriter(os.Stdout)).With("module", "validator"),
+	})
+
+	validator2 := NewThresholdValidator(&ThresholdValidatorOpt{
+		Pubkey:     privateKey.PubKey(),
+		Threshold:  int(threshold),
+		SignState:  signStates[1],
+		Cosigner:   cosigners[1],
+		Peers:      []Cosigner{cosigners[0], cosigners[2]},
+		Leaderless: true,
+		Logger:     tmlog.NewTMLogger(tmlog.NewSyncWriter(os.Stdout)).With("module", "validator"),
+	})
+
+	proposal := tmProto.Proposal{Height: 1, Round: 0, Type: tmProto.ProposalType}
+	signBytes := tm.ProposalSignBytes("chain-id", &proposal)
+
+	require.NoError(t, validator1.SignProposal("chain-id", &proposal))
+	require.True(t, privateKey.PubKey().VerifySignature(signBytes, proposal.Signature))
+
+	vote := tmProto.Vote{Height: 1, Round: 0, Type: tmProto.PrevoteType}
+	signBytes = tm.VoteSignBytes("chain-id", &vote)
+
+	require.NoError(t, validator2.SignVote("chain-id", &vote))
+	require.True(t, privateKey.PubKey().VerifySignature(signBytes, vote.Signature))
+
+	// the watermark of the cosigners prevents a conflicting vote from being signed by another coordinator
+	conflictingVote := tmProto.Vote{
+		Height:  1,
+		Round:   0,
+		Type:    tmProto.PrevoteType,
+		BlockID: tmProto.BlockID{Hash: []byte("conflicting block hash 000000000")},
+	}
+	require.Error(t, validator1.SignVote("chain-id", &conflictingVote))
+}
+
+func TestThresholdValidatorRestartAfterPeerRemoved(t *testing.T) {
+	shares := uint8(3)
+	threshold := uint8(2)
+
+	privateKey := tmCryptoEd25519.GenPrivKey()
+
+	privKeyBytes := [64]byte{}
+	copy(privKeyBytes[:], privateKey[:])
+	secretShares := tsed25519.DealShares(tsed25519.ExpandSecret(privKeyBytes[:32]), threshold, shares)
+
+	rsaKeys := make([]*rsa.PrivateKey, shares)
+	peers := make([]CosignerPeer, shares)
+	for i := range rsaKeys {
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 4096)
+		require.NoError(t, err)
+		rsaKeys[i] = rsaKey
+		peers[i] = CosignerPeer{ID: i + 1, PublicKey: rsaKey.PublicKey}
+	}
+
+	stateFiles := make([]string, shares)
+	for i := range stateFiles {
+		stateFiles[i] = testSignStateFile(t, fmt.Sprintf("state%d.json", i+1))
+	}
+
+	// starts cosigners 1 and 3 with the peers of their configuration, as the cosigner command does
+	start := func(peers []CosignerPeer) *ThresholdValidator {
+		cosigners := make([]Cosigner, 0, 2)
+		var signState1 SignState
+		for _, id := range []int{1, 3} {
+			signState, err := LoadOrCreateSignState(stateFiles[id-1])
+			require.NoError(t, err)
+			if id == 1 {
+				signState1 = signState
+			}
+			cosigners = append(cosigners, NewLocalCosigner(LocalCosignerConfig{
+				CosignerKey: CosignerKey{
+					PubKey:   privateKey.PubKey(),
+					ShareKey: secretShares[id-1],
+					ID:       id,
+				},
+				SignState: &signState,
+				RsaKey:    *rsaKeys[id-1],
+				Peers:     peers,
+				Total:     shares,
+				Threshold: threshold,
+			}))
+		}
+		return NewThresholdValidator(&ThresholdValidatorOpt{
+			Pubkey:     privateKey.PubKey(),
+			Threshold:  int(threshold),
+			SignState:  signState1,
+			Cosigner:   cosigners[0],
+			Peers:      cosigners[1:],
+			Leaderless: true,
+			Logger:     tmlog.NewNopLogger(),
+		})
+	}
+
+	proposal := tmProto.Proposal{Height: 1, Round: 0, Type: tmProto.ProposalType}
+	signBytes := tm.ProposalSignBytes("chain-id", &proposal)
+	require.NoError(t, start(peers).SignProposal("chain-id", &proposal))
+	require.True(t, privateKey.PubKey().VerifySignature(signBytes, proposal.Signature))
+
+	// cosigner 2 was removed from the cluster and from the configuration, the key still has 3 shares
+	validator := start([]CosignerPeer{peers[0], peers[2]})
+
+	proposal = tmProto.Proposal{Height: 2, Round: 0, Type: tmProto.ProposalType}
+	signBytes = tm.ProposalSignBytes("chain-id", &proposal)
+	require.NoError(t, validator.SignProposal("chain-id", &proposal))
+	require.True(t, privateKey.PubKey().VerifySignature(signBytes, proposal.Signature))
+}
+
+func TestThresholdValidatorFollowerCache(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 4096)
+	require.NoError(t, err)
+
+	privateKey := tmCryptoEd25519.GenPrivKey()
+
+	stateFile := testSignStateFile(t, "state.json")
+
+	signState, err := LoadOrCreateSignState(stateFile)
+	require.NoError(t, err)
+
+	cosigner := NewLocalCosigner(LocalCosignerConfig{
+		CosignerKey: CosignerKey{PubKey: privateKey.PubKey(), ID: 1},
+		SignState:   &signState,
+		RsaKey:      *rsaKey,
+		Peers:       []CosignerPeer{{ID: 1, PublicKey: rsaKey.PublicKey}},
+		Total:       2,
+		Threshold:   2,
+	})
+
+	tmpDir, _ := os.MkdirTemp("", "store_test")
+	defer os.RemoveAll(tmpDir)
+
+	// the unreachable peer prevents this node from ever becoming the raft leader
+	raftStore := getMockRaftStore(cosigner, tmpDir)
+	raftStore.Peers = []Cosigner{NewRemoteCosigner(2, "tcp://127.0.0.1:1")}
+
+	validator := NewThresholdValidator(&ThresholdValidatorOpt{
+		Pubkey:    privateKey.PubKey(),
+		Threshold: 2,
+		SignState: signState,
+		Cosigner:  cosigner,
+		Peers:     raftStore.Peers,
+		RaftStore: raftStore,
+		Logger:    tmlog.NewTMLogger(tmlog.NewSyncWriter(os.Stdout)).With("module", "validator"),
+	})
+	raftStore.SetThresholdValidator(validator)
+
+	_, err = raftStore.Open()
+	require.NoError(t, err)
+
+	vote := tmProto.Vote{Height: 1, Round: 0, Type: tmProto.PrevoteType, Timestamp: time.Unix(10, 0)}
+	signBytes := tm.VoteSignBytes("chain-id", &vote)
+	signature, err := privateKey.Sign(signBytes)
+	require.NoError(t, err)
+
+	// LSS replicated from the leader
+	require.NoError(t, validator.SaveLastSignedState(SignStateConsensus{
+		Height:    1,
+		Round:     0,
+		Step:      stepPrevote,
+		Signature: signature,
+		SignBytes: signBytes,
+	}))
+
+	require.NoError(t, validator.SignVote("chain-id", &vote))
+	require.Equal(t, signature, vote.Signature)
+
+	conflictingVote := tmProto.Vote{
+		Height:    1,
+		Round:     0,
+		Type:      tmProto.PrevoteType,
+		Timestamp: time.Unix(10, 0),
+		BlockID:   tmProto.BlockID{Hash: []byte("conflicting block hash 000000000")},
+	}
+	require.Error(t, validator.SignVote("chain-id", &conflictingVote))
+
+	previousHeightVote := tmProto.Vote{Height: 0, Round: 0, Type: tmProto.PrevoteType}
+	err = validator.SignVote("chain-id", &previousHeightVote)
+	require.IsType(t, &BeyondBlockError{}, err)
+}
+
+func TestThresholdValidatorWaitForSignBlocks(t *testing.T) {
+	validator := &ThresholdValidator{}
+	require.NoError(t, validator.waitForSignBlocks(context.Background()))
+
+	atomic.AddInt32(&validator.inFlight, 1)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	require.ErrorIs(t, validator.waitForSignBlocks(ctx), context.DeadlineExceeded)
+
+	go func() {
+		time.Sleep(20 * time.Millisecond)
+		atomic.AddInt32(&validator.inFlight, -1)
+	}()
+	require.NoError(t, validator.waitForSignBlocks(context.Background()))
+}